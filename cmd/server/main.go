@@ -25,6 +25,10 @@ func main() {
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 
+	if container.Config.Scheduler.Enabled {
+		container.Scheduler.Start()
+	}
+
 	go func() {
 		if err := container.App.Listen(addr); err != nil {
 			log.Fatalf("Failed to start server: %v", err)
@@ -35,6 +39,7 @@ func main() {
 	log.Println("Shutting down server...")
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
+	container.Scheduler.Stop()
 	container.ConsulConn.Deregister()
 	if err := container.App.ShutdownWithContext(ctx); err != nil {
 		log.Fatalf("Server forced to shutdown: %v", err)
@@ -1,9 +1,15 @@
 package app
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	"wiki-service/internal/domain/entity"
 	"wiki-service/internal/domain/repository"
 	"wiki-service/internal/domain/usecase"
 	"wiki-service/internal/infrastructure/database"
+	"wiki-service/internal/infrastructure/lock"
 	infrastructureRepository "wiki-service/internal/infrastructure/repository"
 	httpInterface "wiki-service/internal/interface/http"
 	"wiki-service/internal/interface/http/handler"
@@ -11,7 +17,10 @@ import (
 	"wiki-service/pkg/config"
 	"wiki-service/pkg/consul"
 	"wiki-service/pkg/gateway"
+	libs_constant "wiki-service/pkg/libs/constant"
 	"wiki-service/pkg/logger"
+	"wiki-service/pkg/scheduler"
+	"wiki-service/pkg/translator"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/hashicorp/consul/api"
@@ -23,21 +32,34 @@ import (
 
 // Container holds all application dependencies
 type Container struct {
-	Config            *config.Config
-	Logger            *logger.Logger
-	MongoDB           *mongo.Database
-	AuditMiddleware   *middleware.AuditMiddleware
-	WikiRepository    repository.WikiRepository
-	WikiUseCase       usecase.WikiUseCase
-	WikiHandler       *handler.WikiHandler
-	App               *fiber.App
-	UserGateway       gateway.UserGateway
-	FileGateway       gateway.FileGateway
-	MediaGateway      gateway.MediaGateway
-	Consul            *api.Client
-	ConsulConn        consul.Client
-	CacheClientRedis  *cache.RedisCache
-	CachedMainGateway cached.CachedMainGateway
+	Config             *config.Config
+	Logger             *logger.Logger
+	MongoDB            *mongo.Database
+	AuditMiddleware    *middleware.AuditMiddleware
+	WikiRepository     repository.WikiRepository
+	ReviewRepository   repository.ReviewRepository
+	AuditRepository    repository.AuditRepository
+	LanguageRepository repository.LanguageRepository
+	FallbackRepository repository.LanguageFallbackRepository
+	SnapshotRepository repository.CompletionSnapshotRepository
+	WikiUseCase        usecase.WikiUseCase
+	ReviewUseCase      usecase.ReviewUseCase
+	AuditUseCase       usecase.AuditUseCase
+	LanguageUseCase    usecase.LanguageUseCase
+	WikiHandler        *handler.WikiHandler
+	ReviewHandler      *handler.ReviewHandler
+	AuditHandler       *handler.AuditHandler
+	LanguageHandler    *handler.LanguageHandler
+	App                *fiber.App
+	UserGateway        gateway.UserGateway
+	FileGateway        gateway.FileGateway
+	MediaGateway       gateway.MediaGateway
+	Translator         translator.Translator
+	Consul             *api.Client
+	ConsulConn         consul.Client
+	CacheClientRedis   *cache.RedisCache
+	CachedMainGateway  cached.CachedMainGateway
+	Scheduler          *scheduler.Scheduler
 }
 
 // NewContainer initializes all application dependencies
@@ -77,6 +99,16 @@ func NewContainer() (*Container, error) {
 	// Initialize repositories
 	c.initRepositories()
 
+	// Seed the language registry
+	if err := c.seedLanguages(); err != nil {
+		return nil, err
+	}
+
+	// Create indexes
+	if err := c.ensureIndexes(); err != nil {
+		return nil, err
+	}
+
 	// Initialize gateway
 	c.initGateway()
 
@@ -86,9 +118,17 @@ func NewContainer() (*Container, error) {
 	// Initialize file gateway
 	c.initFileGateway()
 
+	// Initialize machine translation provider
+	if err := c.initTranslator(); err != nil {
+		return nil, err
+	}
+
 	// Initialize use cases
 	c.initUseCases()
 
+	// Initialize background jobs
+	c.initScheduler()
+
 	// Initialize handlers
 	c.initHandlers()
 
@@ -121,17 +161,156 @@ func (c *Container) initDatabase() error {
 
 // initRepositories initializes all repositories
 func (c *Container) initRepositories() {
-	c.WikiRepository = infrastructureRepository.NewWikiRepositoryMongo(c.MongoDB)
+	c.WikiRepository = infrastructureRepository.NewWikiRepositoryMongo(c.MongoDB, c.Logger)
+	c.ReviewRepository = infrastructureRepository.NewReviewRepositoryMongo(c.MongoDB)
+	c.AuditRepository = infrastructureRepository.NewAuditRepositoryMongo(c.MongoDB)
+	c.LanguageRepository = infrastructureRepository.NewLanguageRepositoryMongo(c.MongoDB)
+	c.FallbackRepository = infrastructureRepository.NewLanguageFallbackRepositoryMongo(c.MongoDB)
+	c.SnapshotRepository = infrastructureRepository.NewCompletionSnapshotRepositoryMongo(c.MongoDB)
+}
+
+// seedLanguages makes sure the registry contains the default languages and
+// every language stored translations already use. Unknown IDs get a
+// placeholder entry, since the registry rejects languages it does not know.
+func (c *Container) seedLanguages() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	languages := entity.DefaultLanguages()
+	known := make(map[int]bool, len(languages))
+	for _, language := range languages {
+		known[language.ID] = true
+	}
+
+	used, err := c.WikiRepository.GetTranslationLanguages(ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range used {
+		if !known[id] {
+			languages = append(languages, entity.PlaceholderLanguage(id))
+		}
+	}
+
+	inserted, err := c.LanguageRepository.SeedDefaults(ctx, languages)
+	if err != nil {
+		return err
+	}
+	for _, id := range inserted {
+		if !known[id] {
+			c.Logger.Warn(fmt.Sprintf("Language %d is used by wikis but was not registered; added a placeholder entry to name", id))
+		}
+	}
+	c.Logger.Info("Language registry seeded")
+	return nil
+}
+
+// ensureIndexes creates collection indexes. Building an index over a
+// large existing collection can take a while, hence the long timeout.
+func (c *Container) ensureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	if err := c.WikiRepository.EnsureIndexes(ctx); err != nil {
+		return err
+	}
+	c.Logger.Info("Wiki indexes ensured")
+
+	if err := c.ReviewRepository.EnsureIndexes(ctx); err != nil {
+		return err
+	}
+	c.Logger.Info("Review indexes ensured")
+
+	if err := c.AuditRepository.EnsureIndexes(ctx); err != nil {
+		return err
+	}
+	c.Logger.Info("Audit indexes ensured")
+
+	if err := c.SnapshotRepository.EnsureIndexes(ctx); err != nil {
+		return err
+	}
+	c.Logger.Info("Completion snapshot indexes ensured")
+
+	if err := c.FallbackRepository.EnsureIndexes(ctx); err != nil {
+		return err
+	}
+	c.Logger.Info("Language fallback indexes ensured")
+	return nil
 }
 
 // initUseCases initializes all use cases
 func (c *Container) initUseCases() {
-	c.WikiUseCase = usecase.NewWikiUseCase(c.WikiRepository, c.FileGateway, c.UserGateway, c.MediaGateway)
+	c.WikiUseCase = usecase.NewWikiUseCase(c.WikiRepository, c.AuditRepository, c.ReviewRepository, c.LanguageRepository, c.FallbackRepository, c.SnapshotRepository, c.FileGateway, c.UserGateway, c.MediaGateway, c.Translator)
+	c.ReviewUseCase = usecase.NewReviewUseCase(c.ReviewRepository, c.WikiRepository, c.AuditRepository, c.UserGateway)
+	c.AuditUseCase = usecase.NewAuditUseCase(c.AuditRepository)
+	c.LanguageUseCase = usecase.NewLanguageUseCase(c.LanguageRepository, c.FallbackRepository)
+}
+
+// initScheduler registers background jobs; main starts and stops them
+func (c *Container) initScheduler() {
+	c.Scheduler = scheduler.NewScheduler(lock.NewMongoLocker(c.MongoDB), c.Logger)
+
+	interval := time.Duration(c.Config.Scheduler.IntervalSeconds) * time.Second
+	c.Scheduler.Register(scheduler.Job{
+		Name:     "wiki.publish_schedule",
+		Interval: interval,
+		Run:      c.WikiUseCase.RunDueSchedules,
+	})
+
+	retention := time.Duration(c.Config.Trash.RetentionDays) * 24 * time.Hour
+	c.Scheduler.Register(scheduler.Job{
+		Name:     "wiki.purge_trash",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			// File deletion goes through the gateway, which needs a token
+			ctx = context.WithValue(ctx, libs_constant.Token, c.Config.Scheduler.ServiceToken)
+			return c.WikiUseCase.PurgeTrash(ctx, retention)
+		},
+	})
+
+	// Converts wikis still stored in the old element format, including any
+	// written by older instances during a rolling upgrade
+	c.Scheduler.Register(scheduler.Job{
+		Name:     "wiki.migrate_element_payloads",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			migrated, err := c.WikiRepository.MigrateElementPayloads(ctx)
+			if migrated > 0 {
+				c.Logger.Info(fmt.Sprintf("Wiki element payloads migrated: %d wikis", migrated))
+			}
+			return err
+		},
+	})
+
+	// Search documents are rebuilt by one instance at a time after an
+	// upgrade changes their shape; once current, a run is a single query
+	c.Scheduler.Register(scheduler.Job{
+		Name:     "wiki.search_rebuild",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			rebuilt, err := c.WikiRepository.RebuildSearchDocuments(ctx)
+			if rebuilt > 0 {
+				c.Logger.Info(fmt.Sprintf("Wiki search documents rebuilt: %d wikis", rebuilt))
+			}
+			return err
+		},
+	})
+
+	// Runs hourly and overwrites the current day's snapshot, so each day
+	// keeps its last state even if an instance was down at midnight
+	c.Scheduler.Register(scheduler.Job{
+		Name:     "wiki.completion_snapshot",
+		Interval: time.Hour,
+		Run:      c.WikiUseCase.SnapshotCompletion,
+	})
 }
 
 // initHandlers initializes all HTTP handlers
 func (c *Container) initHandlers() {
 	c.WikiHandler = handler.NewWikiHandler(c.WikiUseCase)
+	c.ReviewHandler = handler.NewReviewHandler(c.ReviewUseCase)
+	c.AuditHandler = handler.NewAuditHandler(c.AuditUseCase)
+	c.LanguageHandler = handler.NewLanguageHandler(c.LanguageUseCase)
 }
 
 // initMiddlewares initializes all middlewares
@@ -143,6 +322,9 @@ func (c *Container) initMiddlewares() {
 func (c *Container) setupRouter() {
 	c.App = httpInterface.SetupRouter(
 		c.WikiHandler,
+		c.ReviewHandler,
+		c.AuditHandler,
+		c.LanguageHandler,
 		c.AuditMiddleware,
 		c.UserGateway,
 	)
@@ -175,6 +357,25 @@ func (c *Container) initMediaGateway() {
 	c.Logger.Info("Media gateway initialized successfully")
 }
 
+func (c *Container) initTranslator() error {
+	textTranslator, err := translator.New(translator.Config{
+		Provider: c.Config.Translator.Provider,
+		APIKey:   c.Config.Translator.APIKey,
+		Endpoint: c.Config.Translator.Endpoint,
+		Timeout:  time.Duration(c.Config.Translator.TimeoutSeconds) * time.Second,
+	})
+	if err != nil {
+		return err
+	}
+	if textTranslator == nil {
+		c.Logger.Warn("Machine translation disabled: TRANSLATOR_PROVIDER is not set")
+		return nil
+	}
+	c.Translator = textTranslator
+	c.Logger.Info("Translator initialized: " + textTranslator.Name())
+	return nil
+}
+
 func (c *Container) initConsul() error {
 	consulConn := consul.NewConsulConn(c.Logger, c.Config)
 	c.Consul = consulConn.Connect()
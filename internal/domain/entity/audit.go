@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AuditAction string
+
+const (
+	AuditActionTemplateCreate       AuditAction = "template.create"
+	AuditActionWikiUpdate           AuditAction = "wiki.update"
+	AuditActionWikiPublish          AuditAction = "wiki.publish"
+	AuditActionWikiSchedule         AuditAction = "wiki.schedule"
+	AuditActionWikiCopy             AuditAction = "wiki.copy_translation"
+	AuditActionWikiMachineTranslate AuditAction = "wiki.machine_translate"
+	AuditActionWikiScheduled        AuditAction = "wiki.scheduled_run"
+	AuditActionWikiDelete           AuditAction = "wiki.delete"
+	AuditActionWikiRestore          AuditAction = "wiki.restore"
+	AuditActionWikiPurge            AuditAction = "wiki.purge"
+	AuditActionReviewSubmit         AuditAction = "review.submit"
+	AuditActionReviewApprove        AuditAction = "review.approve"
+	AuditActionReviewReject         AuditAction = "review.reject"
+)
+
+// AuditEntry records who changed which content and how.
+type AuditEntry struct {
+	ID        primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	Action    AuditAction         `bson:"action" json:"action"`
+	ActorID   string              `bson:"actor_id" json:"actor_id"`
+	WikiID    *primitive.ObjectID `bson:"wiki_id,omitempty" json:"wiki_id,omitempty"`
+	WikiType  string              `bson:"wiki_type,omitempty" json:"wiki_type,omitempty"`
+	WikiCode  string              `bson:"wiki_code,omitempty" json:"wiki_code,omitempty"`
+	Language  *int                `bson:"language,omitempty" json:"language,omitempty"`
+	Changes   []FieldChange       `bson:"changes,omitempty" json:"changes,omitempty"`
+	CreatedAt time.Time           `bson:"created_at" json:"created_at"`
+}
+
+// Contributor summarises one user's edits to a wiki, built from the audit
+// trail.
+type Contributor struct {
+	UserID      string    `bson:"_id" json:"user_id"`
+	EditCount   int       `bson:"edit_count" json:"edit_count"`
+	FirstEditAt time.Time `bson:"first_edit_at" json:"first_edit_at"`
+	LastEditAt  time.Time `bson:"last_edit_at" json:"last_edit_at"`
+}
+
+// FieldChange is one field of a before/after diff. Field is a dotted path
+// such as "translation.1.elements.3.value".
+type FieldChange struct {
+	Field  string      `bson:"field" json:"field"`
+	Before interface{} `bson:"before" json:"before"`
+	After  interface{} `bson:"after" json:"after"`
+}
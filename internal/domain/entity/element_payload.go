@@ -0,0 +1,189 @@
+package entity
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// TitlePayload is the content of a title element.
+type TitlePayload struct {
+	Title    string `bson:"title" json:"title"`
+	ImageKey string `bson:"image_key,omitempty" json:"image_key,omitempty"`
+}
+
+// ButtonPayload is the content of a button element, which opens another
+// wiki by code.
+type ButtonPayload struct {
+	Title string `bson:"title" json:"title"`
+	Code  string `bson:"code,omitempty" json:"code,omitempty"`
+	Icon  string `bson:"icon,omitempty" json:"icon,omitempty"`
+}
+
+// LinkPayload is the content of a button_url element, which opens a URL.
+type LinkPayload struct {
+	Title string `bson:"title" json:"title"`
+	URL   string `bson:"url,omitempty" json:"url,omitempty"`
+	Icon  string `bson:"icon,omitempty" json:"icon,omitempty"`
+}
+
+// MediaPayload is the file key of a single-file media element.
+type MediaPayload struct {
+	Key string `bson:"key" json:"key"`
+}
+
+// Legacy JSON shapes of Value, still what API clients send and receive.
+type legacyTitle struct {
+	Title    string `json:"title"`
+	ImageKey string `json:"image_key,omitempty"`
+}
+
+type legacyButton struct {
+	Title      string `json:"title"`
+	Code       string `json:"code"`
+	ButtonIcon string `json:"button_icon"`
+}
+
+type legacyLink struct {
+	Title      string `json:"title"`
+	ButtonURL  string `json:"button_url"`
+	ButtonIcon string `json:"button_icon"`
+}
+
+// SetValue stores an API value on the element, in the form its type uses:
+// title, button and button_url JSON go to their payloads, single-file media
+// keys to Media, and anything else stays in Value as text. Picture keys
+// live in PictureKeys, so a picture keeps no value. A value that does not
+// parse as its type expects is kept as text rather than lost.
+func (e *Element) SetValue(value *string) {
+	e.Value, e.Title, e.Button, e.Link, e.Media = nil, nil, nil, nil, nil
+	if value == nil {
+		return
+	}
+
+	text := strings.TrimSpace(*value)
+	switch strings.ToLower(e.Type) {
+	case "picture":
+		return
+	case "large_picture", "banner", "linked_in", "graphic", "document", "video":
+		if text != "" {
+			e.Media = &MediaPayload{Key: text}
+		}
+		return
+	case "title":
+		// Older titles are plain strings and stay text
+		var title legacyTitle
+		if isJSONObject(text) && json.Unmarshal([]byte(text), &title) == nil {
+			e.Title = &TitlePayload{Title: title.Title, ImageKey: title.ImageKey}
+			return
+		}
+	case "button":
+		var button legacyButton
+		if isJSONObject(text) && json.Unmarshal([]byte(text), &button) == nil {
+			e.Button = &ButtonPayload{Title: button.Title, Code: button.Code, Icon: button.ButtonIcon}
+			return
+		}
+	case "button_url":
+		var link legacyLink
+		if isJSONObject(text) && json.Unmarshal([]byte(text), &link) == nil {
+			e.Link = &LinkPayload{Title: link.Title, URL: link.ButtonURL, Icon: link.ButtonIcon}
+			return
+		}
+	}
+
+	e.Value = value
+}
+
+// RawValue renders the element's content in the legacy Value form the API
+// exposes: JSON for titles, buttons and links, the key for media, the
+// first picture key for pictures, and the text otherwise.
+func (e Element) RawValue() *string {
+	var encoded []byte
+	switch {
+	case e.Title != nil:
+		encoded, _ = json.Marshal(legacyTitle{Title: e.Title.Title, ImageKey: e.Title.ImageKey})
+	case e.Button != nil:
+		encoded, _ = json.Marshal(legacyButton{Title: e.Button.Title, Code: e.Button.Code, ButtonIcon: e.Button.Icon})
+	case e.Link != nil:
+		encoded, _ = json.Marshal(legacyLink{Title: e.Link.Title, ButtonURL: e.Link.URL, ButtonIcon: e.Link.Icon})
+	case e.Media != nil:
+		key := e.Media.Key
+		return &key
+	case e.Value == nil && len(e.PictureKeys) > 0:
+		key := e.PictureKeys[0].Key
+		return &key
+	default:
+		return e.Value
+	}
+
+	value := string(encoded)
+	return &value
+}
+
+// HasContent reports whether the element carries anything: text, a
+// payload, a picture or a video.
+func (e Element) HasContent() bool {
+	if e.Value != nil && strings.TrimSpace(*e.Value) != "" {
+		return true
+	}
+	if e.Title != nil || e.Button != nil || e.Link != nil || e.Media != nil {
+		return true
+	}
+	if len(e.PictureKeys) > 0 {
+		return true
+	}
+	return e.VideoID != nil && strings.TrimSpace(*e.VideoID) != ""
+}
+
+// FileKeys lists the files the element keeps in the file service: its
+// image or document key, picture keys, title image and button or link icon.
+// Videos belong to the media service and are not included. Elements not
+// yet migrated are read as if they were, so their files are still found.
+func (e Element) FileKeys() []string {
+	e.MigratePayload()
+
+	var keys []string
+	add := func(key string) {
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	if e.Media != nil && !strings.EqualFold(e.Type, "video") {
+		add(e.Media.Key)
+	}
+	for _, picture := range e.PictureKeys {
+		add(picture.Key)
+	}
+	if e.Title != nil {
+		add(e.Title.ImageKey)
+	}
+	if e.Button != nil {
+		add(e.Button.Icon)
+	}
+	if e.Link != nil {
+		add(e.Link.Icon)
+	}
+	return keys
+}
+
+// MigratePayload moves structured content that an older document kept in
+// Value into the element's payload, and reports whether anything changed.
+// Values that remain text under SetValue are left alone.
+func (e *Element) MigratePayload() bool {
+	if e.Value == nil {
+		return false
+	}
+
+	migrated := *e
+	migrated.SetValue(e.Value)
+	if migrated.Value != nil {
+		return false
+	}
+
+	*e = migrated
+	return true
+}
+
+func isJSONObject(value string) bool {
+	return strings.HasPrefix(value, "{")
+}
@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func stringPtr(value string) *string {
+	return &value
+}
+
+func TestElementValueRoundTrip(t *testing.T) {
+	tests := []struct {
+		name        string
+		elementType string
+		value       string
+		wantText    bool
+	}{
+		{name: "title", elementType: "title", value: `{"title":"Hello","image_key":"img/a.png"}`},
+		{name: "title without an image", elementType: "title", value: `{"title":"Hello"}`},
+		{name: "plain title", elementType: "title", value: "Hello", wantText: true},
+		{name: "button", elementType: "button", value: `{"title":"Open","code":"W2","button_icon":"icon.png"}`},
+		{name: "link", elementType: "button_url", value: `{"title":"Docs","button_url":"https://example.com","button_icon":""}`},
+		{name: "media key", elementType: "document", value: "files/guide.pdf"},
+		{name: "text", elementType: "text", value: "Some text", wantText: true},
+		{name: "button that is not JSON", elementType: "button", value: "Open", wantText: true},
+		{name: "malformed title JSON", elementType: "title", value: `{"title":`, wantText: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			element := Element{Type: tt.elementType}
+			element.SetValue(stringPtr(tt.value))
+
+			if isText := element.Value != nil; isText != tt.wantText {
+				t.Errorf("kept as text = %v, want %v", isText, tt.wantText)
+			}
+
+			raw := element.RawValue()
+			if raw == nil || *raw != tt.value {
+				t.Fatalf("RawValue() = %v, want %q", raw, tt.value)
+			}
+
+			// Setting the rendered value again must give the same element
+			again := Element{Type: tt.elementType}
+			again.SetValue(raw)
+			if !reflect.DeepEqual(again, element) {
+				t.Errorf("SetValue(RawValue()) = %+v, want %+v", again, element)
+			}
+		})
+	}
+}
+
+func TestElementMigratePayload(t *testing.T) {
+	tests := []struct {
+		name         string
+		element      Element
+		wantMigrated bool
+		wantFileKeys []string
+		wantText     []string
+	}{
+		{
+			name:         "legacy title JSON moves to its payload",
+			element:      Element{Type: "title", Value: stringPtr(`{"title":"Hello","image_key":"img/a.png"}`)},
+			wantMigrated: true,
+			wantFileKeys: []string{"img/a.png"},
+			wantText:     []string{"Hello"},
+		},
+		{
+			name:         "legacy media key moves to its payload",
+			element:      Element{Type: "banner", Value: stringPtr("img/banner.png")},
+			wantMigrated: true,
+			wantFileKeys: []string{"img/banner.png"},
+		},
+		{
+			name:     "text stays in the value",
+			element:  Element{Type: "text", Value: stringPtr("Some text")},
+			wantText: []string{"Some text"},
+		},
+		{
+			name:     "migrated elements are left alone",
+			element:  Element{Type: "title", Title: &TitlePayload{Title: "Hello"}},
+			wantText: []string{"Hello"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			legacy := tt.element
+			rawBefore := legacy.RawValue()
+
+			// Unmigrated elements already read as migrated ones
+			if keys := legacy.FileKeys(); !reflect.DeepEqual(keys, tt.wantFileKeys) {
+				t.Errorf("FileKeys() before migration = %v, want %v", keys, tt.wantFileKeys)
+			}
+			if text := legacy.VisibleText(); !reflect.DeepEqual(text, tt.wantText) {
+				t.Errorf("VisibleText() before migration = %v, want %v", text, tt.wantText)
+			}
+
+			element := tt.element
+			if migrated := element.MigratePayload(); migrated != tt.wantMigrated {
+				t.Fatalf("MigratePayload() = %v, want %v", migrated, tt.wantMigrated)
+			}
+			if element.MigratePayload() {
+				t.Errorf("MigratePayload() changed an element twice")
+			}
+
+			if keys := element.FileKeys(); !reflect.DeepEqual(keys, tt.wantFileKeys) {
+				t.Errorf("FileKeys() = %v, want %v", keys, tt.wantFileKeys)
+			}
+			if text := element.VisibleText(); !reflect.DeepEqual(text, tt.wantText) {
+				t.Errorf("VisibleText() = %v, want %v", text, tt.wantText)
+			}
+
+			// Clients see the same value before and after
+			rawAfter := element.RawValue()
+			if (rawBefore == nil) != (rawAfter == nil) || (rawBefore != nil && *rawBefore != *rawAfter) {
+				t.Errorf("RawValue() changed from %v to %v", rawBefore, rawAfter)
+			}
+		})
+	}
+}
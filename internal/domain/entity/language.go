@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type LanguageDirection string
+
+const (
+	LanguageDirectionLTR LanguageDirection = "ltr"
+	LanguageDirectionRTL LanguageDirection = "rtl"
+)
+
+// Language is an entry of the managed language registry. Its ID is the
+// integer stored in Translation.Language and sent in X-App-Language.
+type Language struct {
+	ID        int               `bson:"_id" json:"id"`
+	Code      string            `bson:"code" json:"code"`
+	Name      string            `bson:"name" json:"name"`
+	Direction LanguageDirection `bson:"direction" json:"direction"`
+	Enabled   bool              `bson:"enabled" json:"enabled"`
+	CreatedAt time.Time         `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time         `bson:"updated_at" json:"updated_at"`
+}
+
+// DefaultLanguages seeds an empty registry with the languages the service
+// has always served.
+func DefaultLanguages() []Language {
+	return []Language{
+		{ID: 1, Code: "en", Name: "English", Direction: LanguageDirectionLTR, Enabled: true},
+		{ID: 2, Code: "vi", Name: "Tiếng Việt", Direction: LanguageDirectionLTR, Enabled: true},
+	}
+}
+
+// PlaceholderLanguage registers a language ID that stored translations use
+// but the registry does not know, so its content stays readable and
+// editable until an administrator names it.
+func PlaceholderLanguage(id int) Language {
+	return Language{
+		ID:        id,
+		Code:      fmt.Sprintf("und-%d", id),
+		Name:      fmt.Sprintf("Language %d", id),
+		Direction: LanguageDirectionLTR,
+		Enabled:   true,
+	}
+}
+
+type FallbackScope string
+
+const (
+	FallbackScopeOrganization FallbackScope = "organization"
+	FallbackScopeType         FallbackScope = "type"
+)
+
+// LanguageFallback is the chain of languages tried when a wiki has no
+// translation in the requested language. An organization chain wins over a
+// wiki type chain.
+type LanguageFallback struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Scope          FallbackScope      `bson:"scope" json:"scope"`
+	ScopeID        string             `bson:"scope_id" json:"scope_id"`
+	Languages      []int              `bson:"languages" json:"languages"`
+	FirstAvailable bool               `bson:"first_available" json:"first_available"`
+	UpdatedBy      string             `bson:"updated_by" json:"updated_by"`
+	UpdatedAt      time.Time          `bson:"updated_at" json:"updated_at"`
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ReviewStatus string
+
+const (
+	ReviewStatusPending  ReviewStatus = "pending"
+	ReviewStatusApproved ReviewStatus = "approved"
+	ReviewStatusRejected ReviewStatus = "rejected"
+	// ReviewStatusStale closes a review whose draft changed before it was
+	// approved; the translation can then be submitted again.
+	ReviewStatusStale ReviewStatus = "stale"
+)
+
+type ReviewDecision string
+
+const (
+	ReviewDecisionNone     ReviewDecision = ""
+	ReviewDecisionApproved ReviewDecision = "approved"
+	ReviewDecisionRejected ReviewDecision = "rejected"
+)
+
+// ReviewRequest asks one or more reviewers to approve a translation before
+// it is published. Revision pins the draft that was submitted so an
+// approval cannot publish edits made after submission.
+type ReviewRequest struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	WikiID      primitive.ObjectID `bson:"wiki_id" json:"wiki_id"`
+	WikiType    string             `bson:"wiki_type" json:"wiki_type"`
+	WikiCode    string             `bson:"wiki_code" json:"wiki_code"`
+	Language    int                `bson:"language" json:"language"`
+	Status      ReviewStatus       `bson:"status" json:"status"`
+	Note        string             `bson:"note" json:"note"`
+	SubmittedBy string             `bson:"submitted_by" json:"submitted_by"`
+	Reviewers   []Reviewer         `bson:"reviewers" json:"reviewers"`
+	Revision    string             `bson:"revision" json:"revision"`
+	ResolvedAt  *time.Time         `bson:"resolved_at,omitempty" json:"resolved_at,omitempty"`
+	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt   time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+type Reviewer struct {
+	UserID    string         `bson:"user_id" json:"user_id"`
+	Name      string         `bson:"name" json:"name"`
+	Email     string         `bson:"email" json:"email"`
+	Decision  ReviewDecision `bson:"decision" json:"decision"`
+	Comment   string         `bson:"comment" json:"comment"`
+	DecidedAt *time.Time     `bson:"decided_at,omitempty" json:"decided_at,omitempty"`
+}
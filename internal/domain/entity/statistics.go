@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TypeSummary is the type-wide completion summary computed by the
+// database. Only template element numbers count towards completion, and an
+// element is filled when it has a value, a picture or a video.
+type TypeSummary struct {
+	TotalWikis int                  `bson:"total_wikis"`
+	Public     int                  `bson:"public"`
+	Private    int                  `bson:"private"`
+	Languages  []LanguageSummary    `bson:"languages"`
+	Elements   []ElementFillSummary `bson:"elements"`
+}
+
+// LanguageSummary counts, for one language, the wikis that carry a
+// translation, those with at least one element filled, those with every
+// template element filled, and the filled elements across all of them.
+type LanguageSummary struct {
+	Language       int `bson:"language"`
+	Wikis          int `bson:"wikis"`
+	Started        int `bson:"started"`
+	Complete       int `bson:"complete"`
+	ElementsFilled int `bson:"elements_filled"`
+}
+
+// ElementFillSummary counts the wikis whose translation in Language has
+// element Number filled.
+type ElementFillSummary struct {
+	Number   int `bson:"number"`
+	Language int `bson:"language"`
+	Filled   int `bson:"filled"`
+}
+
+// CompletionSnapshot freezes one day of a type's completion in one
+// language. There is at most one snapshot per type, language and day;
+// later runs on the same day replace it.
+type CompletionSnapshot struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Type           string             `bson:"type" json:"type"`
+	Language       int                `bson:"language" json:"language"`
+	Date           time.Time          `bson:"date" json:"date"`
+	TotalWikis     int                `bson:"total_wikis" json:"total_wikis"`
+	WikisStarted   int                `bson:"wikis_started" json:"wikis_started"`
+	WikisComplete  int                `bson:"wikis_complete" json:"wikis_complete"`
+	ElementsFilled int                `bson:"elements_filled" json:"elements_filled"`
+	ElementsTotal  int                `bson:"elements_total" json:"elements_total"`
+	TakenAt        time.Time          `bson:"taken_at" json:"taken_at"`
+}
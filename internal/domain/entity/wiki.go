@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -13,28 +14,125 @@ type Wiki struct {
 	Public      int                `bson:"public" json:"public"`
 	Translation []Translation      `bson:"translation" json:"translation"`
 	ImageWiki   string             `bson:"image_wiki" json:"image_wiki"`
+	PublishAt   *time.Time         `bson:"publish_at" json:"publish_at"`
+	UnpublishAt *time.Time         `bson:"unpublish_at" json:"unpublish_at"`
 	CreatedBy   string             `bson:"created_by" json:"created_by"`
 	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
 	UpdatedAt   time.Time          `bson:"updated_at" json:"updated_at"`
+	UpdatedBy   string             `bson:"updated_by" json:"updated_by"`
+	DeletedAt   *time.Time         `bson:"deleted_at" json:"deleted_at"`
+	DeletedBy   string             `bson:"deleted_by" json:"deleted_by"`
 }
 
+// TranslationStatus tracks where the working copy of a translation stands
+// relative to its published copy. An empty status marks documents written
+// before the draft workflow existed; their working copy is served as-is.
+type TranslationStatus string
+
+const (
+	TranslationStatusDraft     TranslationStatus = "draft"
+	TranslationStatusPublished TranslationStatus = "published"
+)
+
+// Translation holds the working (draft) copy edited through UpdateWiki and,
+// once published, a frozen copy served to readers.
 type Translation struct {
-	Language *int      `bson:"language" json:"language"`
-	Title    *string   `bson:"title" json:"title"`
-	Keywords *string   `bson:"keywords" json:"keywords"`
-	Level    *int      `bson:"level" json:"level"`
-	Unit     *string   `bson:"unit" json:"unit"`
-	Elements []Element `bson:"elements" json:"elements"`
+	Language    *int                  `bson:"language" json:"language"`
+	Title       *string               `bson:"title" json:"title"`
+	Keywords    *string               `bson:"keywords" json:"keywords"`
+	Level       *int                  `bson:"level" json:"level"`
+	Unit        *string               `bson:"unit" json:"unit"`
+	Elements    []Element             `bson:"elements" json:"elements"`
+	Status      TranslationStatus     `bson:"status,omitempty" json:"status,omitempty"`
+	Published   *PublishedTranslation `bson:"published,omitempty" json:"published,omitempty"`
+	PublishAt   *time.Time            `bson:"publish_at,omitempty" json:"publish_at,omitempty"`
+	UnpublishAt *time.Time            `bson:"unpublish_at,omitempty" json:"unpublish_at,omitempty"`
+	// Scheduled is the working copy frozen when PublishAt was set; it is
+	// what gets published when PublishAt passes, whatever the draft holds by
+	// then.
+	Scheduled *PublishedTranslation `bson:"scheduled,omitempty" json:"scheduled,omitempty"`
+
+	MachineTranslated *MachineTranslation `bson:"machine_translated,omitempty" json:"machine_translated,omitempty"`
+}
+
+// MachineTranslation marks a working copy whose text was produced by a
+// translation provider and still needs human review. Publishing clears it.
+type MachineTranslation struct {
+	Provider       string    `bson:"provider" json:"provider"`
+	SourceLanguage int       `bson:"source_language" json:"source_language"`
+	TranslatedAt   time.Time `bson:"translated_at" json:"translated_at"`
+	TranslatedBy   string    `bson:"translated_by" json:"translated_by"`
+}
+
+type PublishedTranslation struct {
+	Title       *string   `bson:"title" json:"title"`
+	Keywords    *string   `bson:"keywords" json:"keywords"`
+	Level       *int      `bson:"level" json:"level"`
+	Unit        *string   `bson:"unit" json:"unit"`
+	Elements    []Element `bson:"elements" json:"elements"`
+	PublishedAt time.Time `bson:"published_at" json:"published_at"`
+	PublishedBy string    `bson:"published_by" json:"published_by"`
 }
 
+// Element is one block of a translation. Value holds plain text only;
+// content with structure lives in the payload matching the element's type
+// (see SetValue), so reads never parse JSON out of Value.
 type Element struct {
-	Number      int           `bson:"number" json:"number"`
-	Type        string        `bson:"type" json:"type"`
-	Value       *string       `bson:"value" json:"value"`
-	PictureKeys []PictureItem `bson:"picture_keys" json:"picture_keys"`
-	VideoID     *string       `bson:"video_id,omitempty" json:"video_id,omitempty"`
-	Status      string        `bson:"status" json:"status"`
+	Number      int            `bson:"number" json:"number"`
+	Type        string         `bson:"type" json:"type"`
+	Value       *string        `bson:"value" json:"value"`
+	Title       *TitlePayload  `bson:"title,omitempty" json:"title,omitempty"`
+	Button      *ButtonPayload `bson:"button,omitempty" json:"button,omitempty"`
+	Link        *LinkPayload   `bson:"link,omitempty" json:"link,omitempty"`
+	Media       *MediaPayload  `bson:"media,omitempty" json:"media,omitempty"`
+	PictureKeys []PictureItem  `bson:"picture_keys" json:"picture_keys"`
+	VideoID     *string        `bson:"video_id,omitempty" json:"video_id,omitempty"`
+	Status      string         `bson:"status" json:"status"`
 }
+
+// VisibleText returns the text a reader sees in the element: picture
+// titles, the title of a title, button or link, or a plain text value.
+// Media keys are left out. Elements not yet migrated are read as if they
+// were.
+func (e Element) VisibleText() []string {
+	e.MigratePayload()
+
+	var parts []string
+	add := func(text string) {
+		if strings.TrimSpace(text) != "" {
+			parts = append(parts, text)
+		}
+	}
+
+	for _, picture := range e.PictureKeys {
+		if picture.Title != nil {
+			add(*picture.Title)
+		}
+	}
+
+	switch {
+	case e.Title != nil:
+		add(e.Title.Title)
+	case e.Button != nil:
+		add(e.Button.Title)
+	case e.Link != nil:
+		add(e.Link.Title)
+	case e.Value != nil && !IsMediaElementType(e.Type):
+		add(*e.Value)
+	}
+	return parts
+}
+
+// IsMediaElementType reports whether elements of this type hold a media
+// key rather than text.
+func IsMediaElementType(elementType string) bool {
+	switch strings.ToLower(elementType) {
+	case "picture", "large_picture", "banner", "linked_in", "graphic", "document", "video":
+		return true
+	}
+	return false
+}
+
 type PictureItem struct {
 	Key   string  `bson:"key" json:"key"`
 	Order int     `bson:"order" json:"order"`
@@ -0,0 +1,70 @@
+package entity
+
+// WikiFields selects the parts of a wiki a read needs, by their API field
+// names. A nil set selects everything. Reads use it to project documents
+// and to skip resolving URLs and users nobody asked for.
+type WikiFields map[string]bool
+
+const (
+	WikiFieldCode        = "code"
+	WikiFieldPublic      = "public"
+	WikiFieldImage       = "image_wiki"
+	WikiFieldPublishAt   = "publish_at"
+	WikiFieldUnpublishAt = "unpublish_at"
+	WikiFieldCreatedBy   = "created_by"
+	WikiFieldUpdatedBy   = "updated_by"
+	WikiFieldCreatedAt   = "created_at"
+	WikiFieldUpdatedAt   = "updated_at"
+
+	// Translation fields
+	WikiFieldTitle    = "title"
+	WikiFieldKeywords = "keywords"
+	WikiFieldLevel    = "level"
+	WikiFieldUnit     = "unit"
+	WikiFieldElements = "elements"
+	WikiFieldStatus   = "status"
+
+	// WikiFieldTranslation expands to every translation field
+	WikiFieldTranslation = "translation"
+)
+
+// WikiTranslationFields lists the fields that live on each translation.
+var WikiTranslationFields = []string{
+	WikiFieldTitle,
+	WikiFieldKeywords,
+	WikiFieldLevel,
+	WikiFieldUnit,
+	WikiFieldElements,
+	WikiFieldStatus,
+}
+
+// WikiTopLevelFields lists the fields that live on the wiki itself.
+var WikiTopLevelFields = []string{
+	WikiFieldCode,
+	WikiFieldPublic,
+	WikiFieldImage,
+	WikiFieldPublishAt,
+	WikiFieldUnpublishAt,
+	WikiFieldCreatedBy,
+	WikiFieldUpdatedBy,
+	WikiFieldCreatedAt,
+	WikiFieldUpdatedAt,
+}
+
+// SummaryWikiFields is the lightweight list view: enough to render a row
+// with its title and thumbnail.
+func SummaryWikiFields() WikiFields {
+	return WikiFields{
+		WikiFieldCode:      true,
+		WikiFieldPublic:    true,
+		WikiFieldImage:     true,
+		WikiFieldUpdatedAt: true,
+		WikiFieldTitle:     true,
+		WikiFieldStatus:    true,
+	}
+}
+
+// Has reports whether field is selected.
+func (f WikiFields) Has(field string) bool {
+	return f == nil || f[field]
+}
@@ -0,0 +1,12 @@
+package entity
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// WikiSuggestion is an autocomplete match: just enough to show a wiki in a
+// dropdown and open it.
+type WikiSuggestion struct {
+	WikiID   primitive.ObjectID `bson:"wiki_id"`
+	Code     string             `bson:"code"`
+	Language int                `bson:"language"`
+	Title    string             `bson:"title"`
+}
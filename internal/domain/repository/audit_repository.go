@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"wiki-service/internal/domain/entity"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AuditFilter struct {
+	WikiID   *primitive.ObjectID
+	WikiType string
+	WikiCode string
+	ActorID  string
+	Action   string
+	Language *int
+	From     *time.Time
+	To       *time.Time
+}
+
+type AuditRepository interface {
+	Create(ctx context.Context, entry *entity.AuditEntry) error
+	Find(ctx context.Context, filter AuditFilter, page, limit int) ([]*entity.AuditEntry, int64, error)
+	GetContributors(ctx context.Context, wikiID primitive.ObjectID, actions []entity.AuditAction) ([]*entity.Contributor, error)
+	EnsureIndexes(ctx context.Context) error
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"wiki-service/internal/domain/entity"
+)
+
+type CompletionSnapshotRepository interface {
+	Upsert(ctx context.Context, snapshot *entity.CompletionSnapshot) error
+	GetRange(ctx context.Context, typeParam string, language *int, from, to time.Time) ([]*entity.CompletionSnapshot, error)
+	EnsureIndexes(ctx context.Context) error
+}
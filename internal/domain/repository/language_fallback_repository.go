@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"wiki-service/internal/domain/entity"
+)
+
+type LanguageFallbackRepository interface {
+	GetAll(ctx context.Context) ([]*entity.LanguageFallback, error)
+	Upsert(ctx context.Context, fallback *entity.LanguageFallback) error
+	Delete(ctx context.Context, scope entity.FallbackScope, scopeID string) (bool, error)
+	EnsureIndexes(ctx context.Context) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"wiki-service/internal/domain/entity"
+)
+
+type LanguageRepository interface {
+	GetAll(ctx context.Context) ([]*entity.Language, error)
+	GetByID(ctx context.Context, id int) (*entity.Language, error)
+	Upsert(ctx context.Context, language *entity.Language) error
+	// SeedDefaults inserts the languages the registry does not have yet and
+	// returns the IDs it inserted.
+	SeedDefaults(ctx context.Context, languages []entity.Language) ([]int, error)
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"wiki-service/internal/domain/entity"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ReviewRepository interface {
+	Create(ctx context.Context, review *entity.ReviewRequest) error
+	GetByID(ctx context.Context, id primitive.ObjectID) (*entity.ReviewRequest, error)
+	GetPendingByTranslation(ctx context.Context, wikiID primitive.ObjectID, language int) (*entity.ReviewRequest, error)
+	GetQueue(ctx context.Context, reviewerID string, page, limit int) ([]*entity.ReviewRequest, int64, error)
+	// RecordDecision stores a reviewer's decision only while the review is
+	// pending and that reviewer has not decided yet; it reports whether it
+	// did.
+	RecordDecision(ctx context.Context, id primitive.ObjectID, reviewer entity.Reviewer) (bool, error)
+	// Resolve moves a review from one status to another and reports false
+	// when the review was no longer in the from status.
+	Resolve(ctx context.Context, id primitive.ObjectID, from, to entity.ReviewStatus, at time.Time) (bool, error)
+	EnsureIndexes(ctx context.Context) error
+}
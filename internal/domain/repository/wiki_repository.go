@@ -2,17 +2,93 @@ package repository
 
 import (
 	"context"
+	"time"
 	"wiki-service/internal/domain/entity"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// WikiFilter narrows a wiki listing. Level and Unit match a translation
+// carrying them, in Language when it is set. WithLanguage and
+// WithoutLanguage keep wikis that have, or lack, a translation in that
+// language. Search ranks results by relevance unless Sort is set.
+type WikiFilter struct {
+	Type            string
+	Search          string
+	Language        *int
+	Public          *int
+	Level           *int
+	Unit            string
+	WithLanguage    *int
+	WithoutLanguage *int
+	CreatedBy       string
+	UpdatedFrom     *time.Time
+	UpdatedTo       *time.Time
+	Sort            WikiSort
+	// Draft searches the working copies of translations instead of what
+	// readers see; only preview requests set it.
+	Draft bool
+}
+
+// WikiSort orders a listing. An empty Field sorts by code, or by relevance
+// when searching. Title sorts use the title in the filter's Language when
+// one is set.
+type WikiSort struct {
+	Field      string
+	Descending bool
+}
+
+const (
+	WikiSortCode      = "code"
+	WikiSortUpdatedAt = "updated_at"
+	WikiSortTitle     = "title"
+)
+
+// WikiPageRequest selects a page of a listing. A Cursor from a previous
+// WikiPage continues from that position (keyset pagination); without one,
+// Page is an offset page. The total is only counted when CountTotal is set.
+// Fields limits what is loaded of each wiki; nil loads whole documents.
+type WikiPageRequest struct {
+	Page       int
+	Limit      int
+	Cursor     string
+	CountTotal bool
+	Fields     entity.WikiFields
+}
+
+// WikiPage is one page of a listing. Total is nil unless it was requested.
+// The cursors are opaque and empty when there is nothing in that direction.
+type WikiPage struct {
+	Wikis      []*entity.Wiki
+	Total      *int64
+	NextCursor string
+	PrevCursor string
+}
+
 type WikiRepository interface {
 	CreateTemplate(ctx context.Context, template *entity.WikiTemplate) error
 	GetTemplates(ctx context.Context, typeParam string) (*entity.WikiTemplate, error)
+	GetTemplateTypes(ctx context.Context) ([]string, error)
+	// GetTranslationLanguages returns every language ID stored translations
+	// use, trashed wikis included.
+	GetTranslationLanguages(ctx context.Context) ([]int, error)
 	CreateMany(ctx context.Context, wikis []entity.Wiki, typeParam string) error
-	GetWikis(ctx context.Context, page, limit int, typeParam, search string) ([]*entity.Wiki, int64, error)
+	EnsureIndexes(ctx context.Context) error
+	RebuildSearchDocuments(ctx context.Context) (int, error)
+	MigrateElementPayloads(ctx context.Context) (int, error)
+	GetWikis(ctx context.Context, filter WikiFilter, page WikiPageRequest) (*WikiPage, error)
+	SuggestWikis(ctx context.Context, typeParam, prefix string, language, limit int) ([]*entity.WikiSuggestion, error)
 	GetWikiByID(ctx context.Context, id primitive.ObjectID) (*entity.Wiki, error)
 	GetWikiByCode(ctx context.Context, code string, typeParam string) (*entity.Wiki, error)
+	GetWikisByCodes(ctx context.Context, codes []string, typeParam string) ([]*entity.Wiki, error)
 	UpdateWiki(ctx context.Context, id primitive.ObjectID, wiki *entity.Wiki) error
+	UpdateWikiIfUnchanged(ctx context.Context, wiki *entity.Wiki, updatedAt time.Time) (bool, error)
+	IterateWikis(ctx context.Context, typeParam string, fn func(wiki *entity.Wiki) error) error
+	SummarizeType(ctx context.Context, typeParam string, elementNumbers []int) (*entity.TypeSummary, error)
+	GetDueSchedules(ctx context.Context, now time.Time) ([]*entity.Wiki, error)
+	SoftDeleteWiki(ctx context.Context, id primitive.ObjectID, userID string, now time.Time) (bool, error)
+	RestoreWiki(ctx context.Context, id primitive.ObjectID) (bool, error)
+	GetTrash(ctx context.Context, page, limit int, typeParam string) ([]*entity.Wiki, int64, error)
+	GetTrashedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*entity.Wiki, error)
+	PurgeWiki(ctx context.Context, id primitive.ObjectID, cutoff time.Time) (bool, error)
 }
@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"time"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/domain/repository"
+	"wiki-service/internal/interface/http/dto/request"
+	libs_errors "wiki-service/pkg/libs/errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AuditUseCase interface {
+	GetAuditEntries(ctx context.Context, req request.GetAuditEntriesRequest) ([]*entity.AuditEntry, int64, error)
+}
+
+type auditUseCase struct {
+	auditRepo repository.AuditRepository
+}
+
+func NewAuditUseCase(auditRepo repository.AuditRepository) AuditUseCase {
+	return &auditUseCase{
+		auditRepo: auditRepo,
+	}
+}
+
+func (u *auditUseCase) GetAuditEntries(ctx context.Context, req request.GetAuditEntriesRequest) ([]*entity.AuditEntry, int64, error) {
+	if req.Page < 1 {
+		return nil, 0, libs_errors.InvalidField("page", libs_errors.FieldMustBePositive, "page must be greater than 0", nil)
+	}
+
+	if req.Limit < 1 {
+		return nil, 0, libs_errors.InvalidField("limit", libs_errors.FieldMustBePositive, "limit must be greater than 0", nil)
+	}
+
+	filter := repository.AuditFilter{
+		WikiType: req.Type,
+		WikiCode: req.Code,
+		ActorID:  req.UserID,
+		Action:   req.Action,
+		Language: req.Language,
+		From:     req.From,
+		To:       req.To,
+	}
+
+	if req.WikiID != "" {
+		wikiID, err := primitive.ObjectIDFromHex(req.WikiID)
+		if err != nil {
+			return nil, 0, libs_errors.InvalidID("wiki_id")
+		}
+		filter.WikiID = &wikiID
+	}
+
+	return u.auditRepo.Find(ctx, filter, req.Page, req.Limit)
+}
+
+// recordAudit stores an audit entry. Failures are logged rather than
+// returned so auditing never blocks the change being audited.
+func recordAudit(ctx context.Context, auditRepo repository.AuditRepository, entry *entity.AuditEntry) {
+	if auditRepo == nil || entry == nil {
+		return
+	}
+
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	if err := auditRepo.Create(ctx, entry); err != nil {
+		log.Printf("failed to record audit %s: %v", entry.Action, err)
+	}
+}
+
+func newWikiAuditEntry(action entity.AuditAction, actorID string, wiki *entity.Wiki, language *int) *entity.AuditEntry {
+	entry := &entity.AuditEntry{
+		Action:   action,
+		ActorID:  actorID,
+		Language: language,
+	}
+
+	if wiki != nil {
+		wikiID := wiki.ID
+		entry.WikiID = &wikiID
+		entry.WikiType = wiki.Type
+		entry.WikiCode = wiki.Code
+	}
+
+	return entry
+}
+
+// flattenWiki snapshots the auditable fields of a wiki as dotted paths.
+// Translations are keyed by language and elements by number so reordering
+// an array does not show up as a change.
+func flattenWiki(wiki *entity.Wiki) map[string]interface{} {
+	fields := map[string]interface{}{
+		"public":       wiki.Public,
+		"image_wiki":   wiki.ImageWiki,
+		"publish_at":   timeValue(wiki.PublishAt),
+		"unpublish_at": timeValue(wiki.UnpublishAt),
+		"deleted_at":   timeValue(wiki.DeletedAt),
+	}
+
+	for _, translation := range wiki.Translation {
+		prefix := "translation.none."
+		if translation.Language != nil {
+			prefix = fmt.Sprintf("translation.%d.", *translation.Language)
+		}
+
+		fields[prefix+"title"] = stringValue(translation.Title)
+		fields[prefix+"keywords"] = stringValue(translation.Keywords)
+		fields[prefix+"level"] = intValue(translation.Level)
+		fields[prefix+"unit"] = stringValue(translation.Unit)
+		fields[prefix+"status"] = string(translation.Status)
+		fields[prefix+"publish_at"] = timeValue(translation.PublishAt)
+		fields[prefix+"unpublish_at"] = timeValue(translation.UnpublishAt)
+
+		var publishedAt *time.Time
+		if translation.Published != nil {
+			publishedAt = &translation.Published.PublishedAt
+		}
+		fields[prefix+"published_at"] = timeValue(publishedAt)
+
+		for _, elem := range translation.Elements {
+			// Compare unmigrated elements in their migrated form
+			elem.MigratePayload()
+			elemPrefix := fmt.Sprintf("%selements.%d.", prefix, elem.Number)
+			fields[elemPrefix+"type"] = elem.Type
+			fields[elemPrefix+"value"] = stringValue(elem.RawValue())
+			fields[elemPrefix+"video_id"] = stringValue(elem.VideoID)
+			fields[elemPrefix+"status"] = elem.Status
+			if len(elem.PictureKeys) > 0 {
+				pictureKeys, _ := json.Marshal(elem.PictureKeys)
+				fields[elemPrefix+"picture_keys"] = string(pictureKeys)
+			}
+		}
+	}
+
+	return fields
+}
+
+// diffFields lists the paths whose values differ between two snapshots,
+// sorted by path.
+func diffFields(before, after map[string]interface{}) []entity.FieldChange {
+	var changes []entity.FieldChange
+
+	for field, afterValue := range after {
+		beforeValue, ok := before[field]
+		if !ok || !reflect.DeepEqual(beforeValue, afterValue) {
+			changes = append(changes, entity.FieldChange{Field: field, Before: beforeValue, After: afterValue})
+		}
+	}
+
+	for field, beforeValue := range before {
+		if _, ok := after[field]; !ok {
+			changes = append(changes, entity.FieldChange{Field: field, Before: beforeValue, After: nil})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+
+	return changes
+}
+
+func stringValue(value *string) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
+
+func intValue(value *int) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
+
+func timeValue(value *time.Time) interface{} {
+	if value == nil {
+		return nil
+	}
+	return value.UTC()
+}
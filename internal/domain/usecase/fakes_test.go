@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/domain/repository"
+	"wiki-service/pkg/gateway"
+	user_gateway_dto "wiki-service/pkg/gateway/dto/user"
+	libs_errors "wiki-service/pkg/libs/errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Fakes embed the interface they stand in for, so a call a test did not
+// expect panics instead of passing silently.
+
+// cloneWiki copies a wiki the way a database round trip would, so a use
+// case never shares memory with what the fake stores.
+func cloneWiki(wiki *entity.Wiki) *entity.Wiki {
+	raw, err := bson.Marshal(wiki)
+	if err != nil {
+		panic(err)
+	}
+	var clone entity.Wiki
+	if err := bson.Unmarshal(raw, &clone); err != nil {
+		panic(err)
+	}
+	return &clone
+}
+
+type fakeWikiRepo struct {
+	repository.WikiRepository
+	wikis   map[primitive.ObjectID]*entity.Wiki
+	trashed []*entity.Wiki
+	purge   func(id primitive.ObjectID) (bool, error)
+}
+
+func newFakeWikiRepo(wikis ...*entity.Wiki) *fakeWikiRepo {
+	repo := &fakeWikiRepo{wikis: make(map[primitive.ObjectID]*entity.Wiki)}
+	for _, wiki := range wikis {
+		repo.wikis[wiki.ID] = cloneWiki(wiki)
+	}
+	return repo
+}
+
+func (r *fakeWikiRepo) GetWikiByID(ctx context.Context, id primitive.ObjectID) (*entity.Wiki, error) {
+	wiki, ok := r.wikis[id]
+	if !ok {
+		return nil, nil
+	}
+	return cloneWiki(wiki), nil
+}
+
+func (r *fakeWikiRepo) UpdateWiki(ctx context.Context, id primitive.ObjectID, wiki *entity.Wiki) error {
+	r.wikis[id] = cloneWiki(wiki)
+	return nil
+}
+
+func (r *fakeWikiRepo) GetTrashedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*entity.Wiki, error) {
+	return r.trashed, nil
+}
+
+func (r *fakeWikiRepo) PurgeWiki(ctx context.Context, id primitive.ObjectID, cutoff time.Time) (bool, error) {
+	return r.purge(id)
+}
+
+type fakeReviewRepo struct {
+	repository.ReviewRepository
+	reviews map[primitive.ObjectID]*entity.ReviewRequest
+	// interleave runs a hook once before the named write ("record" or
+	// "resolve") to stand in for a concurrent request
+	interleave map[string]func()
+}
+
+func newFakeReviewRepo() *fakeReviewRepo {
+	return &fakeReviewRepo{reviews: make(map[primitive.ObjectID]*entity.ReviewRequest)}
+}
+
+func (r *fakeReviewRepo) before(op string) {
+	if hook, ok := r.interleave[op]; ok {
+		delete(r.interleave, op)
+		hook()
+	}
+}
+
+func (r *fakeReviewRepo) Create(ctx context.Context, review *entity.ReviewRequest) error {
+	// Mirrors the unique index on pending reviews
+	if pending, _ := r.GetPendingByTranslation(ctx, review.WikiID, review.Language); pending != nil {
+		return &libs_errors.AppError{Kind: libs_errors.KindConflict, Code: libs_errors.CodeConflict, Message: "resource already exists"}
+	}
+	review.ID = primitive.NewObjectID()
+	stored := *review
+	stored.Reviewers = append([]entity.Reviewer(nil), review.Reviewers...)
+	r.reviews[review.ID] = &stored
+	return nil
+}
+
+func (r *fakeReviewRepo) GetByID(ctx context.Context, id primitive.ObjectID) (*entity.ReviewRequest, error) {
+	review, ok := r.reviews[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *review
+	copied.Reviewers = append([]entity.Reviewer(nil), review.Reviewers...)
+	return &copied, nil
+}
+
+func (r *fakeReviewRepo) GetPendingByTranslation(ctx context.Context, wikiID primitive.ObjectID, language int) (*entity.ReviewRequest, error) {
+	for _, review := range r.reviews {
+		if review.WikiID == wikiID && review.Language == language && review.Status == entity.ReviewStatusPending {
+			return r.GetByID(ctx, review.ID)
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeReviewRepo) RecordDecision(ctx context.Context, id primitive.ObjectID, reviewer entity.Reviewer) (bool, error) {
+	r.before("record")
+	review, ok := r.reviews[id]
+	if !ok || review.Status != entity.ReviewStatusPending {
+		return false, nil
+	}
+	for i := range review.Reviewers {
+		if review.Reviewers[i].UserID == reviewer.UserID && review.Reviewers[i].Decision == entity.ReviewDecisionNone {
+			review.Reviewers[i].Decision = reviewer.Decision
+			review.Reviewers[i].Comment = reviewer.Comment
+			review.Reviewers[i].DecidedAt = reviewer.DecidedAt
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeReviewRepo) Resolve(ctx context.Context, id primitive.ObjectID, from, to entity.ReviewStatus, at time.Time) (bool, error) {
+	r.before("resolve")
+	review, ok := r.reviews[id]
+	if !ok || review.Status != from {
+		return false, nil
+	}
+	review.Status = to
+	review.ResolvedAt = &at
+	return true, nil
+}
+
+type fakeAuditRepo struct {
+	repository.AuditRepository
+	entries []*entity.AuditEntry
+}
+
+func (r *fakeAuditRepo) Create(ctx context.Context, entry *entity.AuditEntry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *fakeAuditRepo) actions() []entity.AuditAction {
+	var actions []entity.AuditAction
+	for _, entry := range r.entries {
+		actions = append(actions, entry.Action)
+	}
+	return actions
+}
+
+// fakeUserGateway knows every user whose ID it is asked about.
+type fakeUserGateway struct {
+	gateway.UserGateway
+}
+
+func (g *fakeUserGateway) GetUserByID(ctx context.Context, userID string) (*user_gateway_dto.CurrentUser, error) {
+	return &user_gateway_dto.CurrentUser{ID: userID, Fullname: userID}, nil
+}
+
+type fakeFileGateway struct {
+	gateway.FileGateway
+	deletedImages []string
+	deletedPDFs   []string
+}
+
+func (g *fakeFileGateway) DeleteImage(ctx context.Context, imageKey string) error {
+	g.deletedImages = append(g.deletedImages, imageKey)
+	return nil
+}
+
+func (g *fakeFileGateway) DeletePDF(ctx context.Context, pdfKey string) error {
+	g.deletedPDFs = append(g.deletedPDFs, pdfKey)
+	return nil
+}
+
+// errorCode returns the application error code of err, or "" when err is
+// nil or not an application error.
+func errorCode(err error) string {
+	if appErr, ok := libs_errors.As(err); ok {
+		return appErr.Code
+	}
+	return ""
+}
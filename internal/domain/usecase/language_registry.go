@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/domain/repository"
+	libs_errors "wiki-service/pkg/libs/errors"
+)
+
+const languageRegistryTTL = time.Minute
+
+// languageSnapshot is one consistent load of the registry and the fallback
+// chains configured on top of it.
+type languageSnapshot struct {
+	languages map[int]*entity.Language
+	fallbacks map[string]*entity.LanguageFallback
+}
+
+// languageRegistry answers "is this language ID usable" and "what should be
+// served instead" without a database round trip per request. Both
+// collections are small, so they are loaded whole and kept for
+// languageRegistryTTL; edits reach every instance within that window.
+type languageRegistry struct {
+	languageRepo repository.LanguageRepository
+	fallbackRepo repository.LanguageFallbackRepository
+	mu           sync.Mutex
+	snapshot     *languageSnapshot
+	expiresAt    time.Time
+}
+
+func newLanguageRegistry(languageRepo repository.LanguageRepository, fallbackRepo repository.LanguageFallbackRepository) *languageRegistry {
+	return &languageRegistry{
+		languageRepo: languageRepo,
+		fallbackRepo: fallbackRepo,
+	}
+}
+
+func (r *languageRegistry) load(ctx context.Context) (*languageSnapshot, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.snapshot != nil && time.Now().Before(r.expiresAt) {
+		return r.snapshot, nil
+	}
+
+	languages, err := r.languageRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fallbacks, err := r.fallbackRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &languageSnapshot{
+		languages: make(map[int]*entity.Language, len(languages)),
+		fallbacks: make(map[string]*entity.LanguageFallback, len(fallbacks)),
+	}
+	for _, language := range languages {
+		snapshot.languages[language.ID] = language
+	}
+	for _, fallback := range fallbacks {
+		snapshot.fallbacks[fallbackKey(fallback.Scope, fallback.ScopeID)] = fallback
+	}
+
+	r.snapshot = snapshot
+	r.expiresAt = time.Now().Add(languageRegistryTTL)
+	return snapshot, nil
+}
+
+// Enabled returns the registry entry for id, or a validation error for the
+// given field when the language is unknown or disabled.
+func (r *languageRegistry) Enabled(ctx context.Context, field string, id int) (*entity.Language, error) {
+	snapshot, err := r.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	language, ok := snapshot.languages[id]
+	if !ok || !language.Enabled {
+		return nil, libs_errors.InvalidField(field, libs_errors.FieldUnsupportedLanguage,
+			fmt.Sprintf("language %d is not supported", id), map[string]interface{}{"value": id})
+	}
+	return language, nil
+}
+
+// Chain returns the languages to try, in order, for a reader asking for
+// requested: the requested language itself followed by the enabled languages
+// of the organization chain, or of the wiki type chain when the organization
+// has none. firstAvailable reports whether any remaining translation may be
+// served as a last resort.
+func (r *languageRegistry) Chain(ctx context.Context, organizationID, wikiType string, requested int) (chain []int, firstAvailable bool, err error) {
+	snapshot, err := r.load(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	chain = []int{requested}
+
+	fallback := snapshot.fallbacks[fallbackKey(entity.FallbackScopeOrganization, organizationID)]
+	if fallback == nil || organizationID == "" {
+		fallback = snapshot.fallbacks[fallbackKey(entity.FallbackScopeType, wikiType)]
+	}
+	if fallback == nil {
+		return chain, false, nil
+	}
+
+	for _, language := range fallback.Languages {
+		if language == requested {
+			continue
+		}
+		if entry, ok := snapshot.languages[language]; ok && entry.Enabled {
+			chain = append(chain, language)
+		}
+	}
+	return chain, fallback.FirstAvailable, nil
+}
+
+// EnabledLanguages returns the enabled registry entries ordered by ID.
+func (r *languageRegistry) EnabledLanguages(ctx context.Context) ([]*entity.Language, error) {
+	snapshot, err := r.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	languages := make([]*entity.Language, 0, len(snapshot.languages))
+	for _, language := range snapshot.languages {
+		if language.Enabled {
+			languages = append(languages, language)
+		}
+	}
+	sort.Slice(languages, func(i, j int) bool { return languages[i].ID < languages[j].ID })
+	return languages, nil
+}
+
+// IsEnabled reports whether id is an enabled language, treating registry
+// load failures as "no".
+func (r *languageRegistry) IsEnabled(ctx context.Context, id int) bool {
+	snapshot, err := r.load(ctx)
+	if err != nil {
+		return false
+	}
+	language, ok := snapshot.languages[id]
+	return ok && language.Enabled
+}
+
+func fallbackKey(scope entity.FallbackScope, scopeID string) string {
+	return string(scope) + ":" + scopeID
+}
@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/domain/repository"
+	"wiki-service/internal/interface/http/dto/request"
+	libs_errors "wiki-service/pkg/libs/errors"
+)
+
+// languageCodePattern accepts ISO 639-1/639-2 codes with an optional
+// region, e.g. "en", "vi", "pt-BR".
+var languageCodePattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z]{2})?$`)
+
+type LanguageUseCase interface {
+	GetLanguages(ctx context.Context, includeDisabled bool) ([]*entity.Language, error)
+	SaveLanguage(ctx context.Context, id int, req request.SaveLanguageRequest) (*entity.Language, error)
+	GetFallbacks(ctx context.Context) ([]*entity.LanguageFallback, error)
+	SaveFallback(ctx context.Context, scope, scopeID string, req request.SaveLanguageFallbackRequest, userID string) (*entity.LanguageFallback, error)
+	DeleteFallback(ctx context.Context, scope, scopeID string) error
+}
+
+type languageUseCase struct {
+	languageRepo repository.LanguageRepository
+	fallbackRepo repository.LanguageFallbackRepository
+}
+
+func NewLanguageUseCase(languageRepo repository.LanguageRepository, fallbackRepo repository.LanguageFallbackRepository) LanguageUseCase {
+	return &languageUseCase{
+		languageRepo: languageRepo,
+		fallbackRepo: fallbackRepo,
+	}
+}
+
+func (u *languageUseCase) GetLanguages(ctx context.Context, includeDisabled bool) ([]*entity.Language, error) {
+	languages, err := u.languageRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if includeDisabled {
+		return languages, nil
+	}
+
+	enabled := make([]*entity.Language, 0, len(languages))
+	for _, language := range languages {
+		if language.Enabled {
+			enabled = append(enabled, language)
+		}
+	}
+	return enabled, nil
+}
+
+func (u *languageUseCase) SaveLanguage(ctx context.Context, id int, req request.SaveLanguageRequest) (*entity.Language, error) {
+	if id <= 0 {
+		return nil, libs_errors.InvalidField("id", libs_errors.FieldMustBePositive, "id must be greater than 0", nil)
+	}
+
+	code := strings.TrimSpace(req.Code)
+	if code == "" {
+		return nil, libs_errors.Required("code")
+	}
+	if !languageCodePattern.MatchString(code) {
+		return nil, libs_errors.InvalidField("code", libs_errors.FieldInvalidFormat, "code must be an ISO 639 language code", nil)
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, libs_errors.Required("name")
+	}
+
+	direction := entity.LanguageDirection(req.Direction)
+	switch direction {
+	case "":
+		direction = entity.LanguageDirectionLTR
+	case entity.LanguageDirectionLTR, entity.LanguageDirectionRTL:
+	default:
+		return nil, libs_errors.InvalidField("direction", libs_errors.FieldInvalidFormat, "direction must be ltr or rtl", nil)
+	}
+
+	languages, err := u.languageRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing *entity.Language
+	for _, language := range languages {
+		if language.ID == id {
+			existing = language
+			continue
+		}
+		if language.Code == code {
+			return nil, libs_errors.InvalidField("code", libs_errors.FieldDuplicate, "code is already used by another language", map[string]interface{}{"value": code})
+		}
+	}
+
+	now := time.Now()
+	language := &entity.Language{
+		ID:        id,
+		Code:      code,
+		Name:      name,
+		Direction: direction,
+		Enabled:   true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if existing != nil {
+		language.Enabled = existing.Enabled
+		language.CreatedAt = existing.CreatedAt
+	}
+	if req.Enabled != nil {
+		language.Enabled = *req.Enabled
+	}
+
+	if err := u.languageRepo.Upsert(ctx, language); err != nil {
+		return nil, err
+	}
+	return language, nil
+}
+
+func (u *languageUseCase) GetFallbacks(ctx context.Context) ([]*entity.LanguageFallback, error) {
+	return u.fallbackRepo.GetAll(ctx)
+}
+
+func (u *languageUseCase) SaveFallback(ctx context.Context, scope, scopeID string, req request.SaveLanguageFallbackRequest, userID string) (*entity.LanguageFallback, error) {
+	fallbackScope, err := parseFallbackScope(scope, scopeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Languages) == 0 && !req.FirstAvailable {
+		return nil, libs_errors.InvalidField("languages", libs_errors.FieldRequired, "languages are required unless first_available is set", nil)
+	}
+
+	languages, err := u.languageRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[int]bool, len(languages))
+	for _, language := range languages {
+		known[language.ID] = true
+	}
+
+	seen := make(map[int]bool, len(req.Languages))
+	for i, id := range req.Languages {
+		field := fmt.Sprintf("languages[%d]", i)
+		if !known[id] {
+			return nil, libs_errors.InvalidField(field, libs_errors.FieldUnsupportedLanguage,
+				fmt.Sprintf("language %d is not supported", id), map[string]interface{}{"value": id})
+		}
+		if seen[id] {
+			return nil, libs_errors.InvalidField(field, libs_errors.FieldDuplicate,
+				fmt.Sprintf("duplicate language: %d", id), map[string]interface{}{"value": id})
+		}
+		seen[id] = true
+	}
+
+	fallback := &entity.LanguageFallback{
+		Scope:          fallbackScope,
+		ScopeID:        scopeID,
+		Languages:      req.Languages,
+		FirstAvailable: req.FirstAvailable,
+		UpdatedBy:      userID,
+		UpdatedAt:      time.Now(),
+	}
+	if fallback.Languages == nil {
+		fallback.Languages = []int{}
+	}
+
+	if err := u.fallbackRepo.Upsert(ctx, fallback); err != nil {
+		return nil, err
+	}
+	return fallback, nil
+}
+
+func (u *languageUseCase) DeleteFallback(ctx context.Context, scope, scopeID string) error {
+	fallbackScope, err := parseFallbackScope(scope, scopeID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := u.fallbackRepo.Delete(ctx, fallbackScope, scopeID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return libs_errors.NotFound(libs_errors.CodeFallbackNotFound, "language fallback not found")
+	}
+	return nil
+}
+
+func parseFallbackScope(scope, scopeID string) (entity.FallbackScope, error) {
+	fallbackScope := entity.FallbackScope(scope)
+	if fallbackScope != entity.FallbackScopeOrganization && fallbackScope != entity.FallbackScopeType {
+		return "", libs_errors.InvalidField("scope", libs_errors.FieldInvalidFormat, "scope must be organization or type", nil)
+	}
+	if strings.TrimSpace(scopeID) == "" {
+		return "", libs_errors.Required("scope_id")
+	}
+	return fallbackScope, nil
+}
@@ -0,0 +1,447 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/domain/repository"
+	"wiki-service/internal/interface/http/dto/request"
+	"wiki-service/internal/interface/http/dto/response.go"
+	"wiki-service/internal/interface/http/mapper"
+	"wiki-service/pkg/gateway"
+	libs_errors "wiki-service/pkg/libs/errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ReviewUseCase interface {
+	SubmitReview(ctx context.Context, wikiID string, language int, req request.SubmitReviewRequest, userID string) (*response.ReviewResponse, error)
+	GetReview(ctx context.Context, id string) (*response.ReviewResponse, error)
+	GetReviewQueue(ctx context.Context, userID string, page, limit int) ([]*response.ReviewResponse, int64, error)
+	ApproveReview(ctx context.Context, id string, req request.ReviewDecisionRequest, userID string) (*response.ReviewResponse, error)
+	RejectReview(ctx context.Context, id string, req request.ReviewDecisionRequest, userID string) (*response.ReviewResponse, error)
+}
+
+type reviewUseCase struct {
+	reviewRepo  repository.ReviewRepository
+	wikiRepo    repository.WikiRepository
+	auditRepo   repository.AuditRepository
+	userGateway gateway.UserGateway
+}
+
+func NewReviewUseCase(
+	reviewRepo repository.ReviewRepository,
+	wikiRepo repository.WikiRepository,
+	auditRepo repository.AuditRepository,
+	userGateway gateway.UserGateway,
+) ReviewUseCase {
+	return &reviewUseCase{
+		reviewRepo:  reviewRepo,
+		wikiRepo:    wikiRepo,
+		auditRepo:   auditRepo,
+		userGateway: userGateway,
+	}
+}
+
+func (u *reviewUseCase) SubmitReview(ctx context.Context, wikiID string, language int, req request.SubmitReviewRequest, userID string) (*response.ReviewResponse, error) {
+	if userID == "" {
+		return nil, libs_errors.Required("user_id")
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(wikiID)
+	if err != nil {
+		return nil, libs_errors.InvalidID("id")
+	}
+
+	if len(req.ReviewerIDs) == 0 {
+		return nil, libs_errors.InvalidField("reviewer_ids", libs_errors.FieldRequired, "at least one reviewer is required", nil)
+	}
+
+	wiki, err := u.wikiRepo.GetWikiByID(ctx, objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if wiki == nil {
+		return nil, libs_errors.NotFound(libs_errors.CodeWikiNotFound, "wiki not found")
+	}
+
+	translation := findTranslation(wiki, language)
+	if translation == nil {
+		return nil, libs_errors.NotFound(libs_errors.CodeTranslationNotFound, "translation not found")
+	}
+
+	if translation.Status != entity.TranslationStatusDraft {
+		return nil, libs_errors.Conflict(libs_errors.CodeNothingToReview, "translation has no unpublished changes")
+	}
+
+	pending, err := u.reviewRepo.GetPendingByTranslation(ctx, objectID, language)
+	if err != nil {
+		return nil, err
+	}
+
+	if pending != nil {
+		return nil, libs_errors.Conflict(libs_errors.CodeReviewPending, "translation already has a pending review")
+	}
+
+	reviewers, err := u.resolveReviewers(ctx, req.ReviewerIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := translationRevision(translation)
+	if err != nil {
+		return nil, libs_errors.Internal("failed to fingerprint translation", err)
+	}
+
+	now := time.Now()
+	review := &entity.ReviewRequest{
+		WikiID:      wiki.ID,
+		WikiType:    wiki.Type,
+		WikiCode:    wiki.Code,
+		Language:    language,
+		Status:      entity.ReviewStatusPending,
+		Note:        req.Note,
+		SubmittedBy: userID,
+		Reviewers:   reviewers,
+		Revision:    revision,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := u.reviewRepo.Create(ctx, review); err != nil {
+		// The unique index on pending reviews catches concurrent submissions
+		if appErr, ok := libs_errors.As(err); ok && appErr.Kind == libs_errors.KindConflict {
+			return nil, libs_errors.Conflict(libs_errors.CodeReviewPending, "translation already has a pending review")
+		}
+		return nil, fmt.Errorf("failed to save review: %w", err)
+	}
+
+	recordAudit(ctx, u.auditRepo, newWikiAuditEntry(entity.AuditActionReviewSubmit, userID, wiki, &language))
+
+	return mapper.ReviewToResponse(review), nil
+}
+
+func (u *reviewUseCase) GetReview(ctx context.Context, id string) (*response.ReviewResponse, error) {
+	review, err := u.getReview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ReviewToResponse(review), nil
+}
+
+func (u *reviewUseCase) GetReviewQueue(ctx context.Context, userID string, page, limit int) ([]*response.ReviewResponse, int64, error) {
+	if userID == "" {
+		return nil, 0, libs_errors.Required("user_id")
+	}
+
+	if page < 1 {
+		return nil, 0, libs_errors.InvalidField("page", libs_errors.FieldMustBePositive, "page must be greater than 0", nil)
+	}
+
+	if limit < 1 {
+		return nil, 0, libs_errors.InvalidField("limit", libs_errors.FieldMustBePositive, "limit must be greater than 0", nil)
+	}
+
+	reviews, total, err := u.reviewRepo.GetQueue(ctx, userID, page, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	responses := make([]*response.ReviewResponse, len(reviews))
+	for i, review := range reviews {
+		responses[i] = mapper.ReviewToResponse(review)
+	}
+
+	return responses, total, nil
+}
+
+func (u *reviewUseCase) ApproveReview(ctx context.Context, id string, req request.ReviewDecisionRequest, userID string) (*response.ReviewResponse, error) {
+	return u.decide(ctx, id, userID, entity.ReviewDecisionApproved, req.Comment)
+}
+
+func (u *reviewUseCase) RejectReview(ctx context.Context, id string, req request.ReviewDecisionRequest, userID string) (*response.ReviewResponse, error) {
+	if strings.TrimSpace(req.Comment) == "" {
+		return nil, libs_errors.InvalidField("comment", libs_errors.FieldRequired, "comment is required when rejecting", nil)
+	}
+
+	return u.decide(ctx, id, userID, entity.ReviewDecisionRejected, req.Comment)
+}
+
+func (u *reviewUseCase) decide(ctx context.Context, id, userID string, decision entity.ReviewDecision, comment string) (*response.ReviewResponse, error) {
+	if userID == "" {
+		return nil, libs_errors.Required("user_id")
+	}
+
+	review, err := u.getReview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if review.Status != entity.ReviewStatusPending {
+		return nil, libs_errors.Conflict(libs_errors.CodeReviewClosed, fmt.Sprintf("review is already %s", review.Status))
+	}
+
+	var reviewer *entity.Reviewer
+	for i := range review.Reviewers {
+		if review.Reviewers[i].UserID == userID {
+			reviewer = &review.Reviewers[i]
+			break
+		}
+	}
+
+	if reviewer == nil {
+		return nil, libs_errors.Forbidden(libs_errors.CodeNotReviewer, "user is not a reviewer of this review")
+	}
+
+	if reviewer.Decision != entity.ReviewDecisionNone {
+		return nil, libs_errors.Conflict(libs_errors.CodeAlreadyDecided, "reviewer has already decided")
+	}
+
+	now := time.Now()
+	recorded, err := u.reviewRepo.RecordDecision(ctx, review.ID, entity.Reviewer{
+		UserID:    userID,
+		Decision:  decision,
+		Comment:   comment,
+		DecidedAt: &now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !recorded {
+		// Another request closed the review or decided for this reviewer
+		return nil, u.decisionConflict(ctx, review.ID)
+	}
+
+	// Decisions recorded concurrently by other reviewers count too
+	if review, err = u.getReview(ctx, id); err != nil {
+		return nil, err
+	}
+
+	// Any rejection closes the review; approval needs every reviewer.
+	// Only the request that moves the review out of pending publishes.
+	status := reviewOutcome(review.Reviewers)
+	if status != entity.ReviewStatusPending {
+		resolved, err := u.reviewRepo.Resolve(ctx, review.ID, entity.ReviewStatusPending, status, now)
+		if err != nil {
+			return nil, err
+		}
+		if !resolved {
+			// A concurrent decision resolved the review first
+			if review, err = u.getReview(ctx, id); err != nil {
+				return nil, err
+			}
+		} else {
+			if status == entity.ReviewStatusApproved {
+				if err := u.publishApproved(ctx, review, userID, now); err != nil {
+					return nil, err
+				}
+			}
+			review.Status = status
+			review.ResolvedAt = &now
+		}
+	}
+
+	action := entity.AuditActionReviewApprove
+	if decision == entity.ReviewDecisionRejected {
+		action = entity.AuditActionReviewReject
+	}
+	entry := newWikiAuditEntry(action, userID, nil, &review.Language)
+	entry.WikiID = &review.WikiID
+	entry.WikiType = review.WikiType
+	entry.WikiCode = review.WikiCode
+	recordAudit(ctx, u.auditRepo, entry)
+
+	return mapper.ReviewToResponse(review), nil
+}
+
+// decisionConflict explains why a decision could not be recorded.
+func (u *reviewUseCase) decisionConflict(ctx context.Context, id primitive.ObjectID) error {
+	review, err := u.reviewRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if review == nil {
+		return libs_errors.NotFound(libs_errors.CodeReviewNotFound, "review not found")
+	}
+	if review.Status != entity.ReviewStatusPending {
+		return libs_errors.Conflict(libs_errors.CodeReviewClosed, fmt.Sprintf("review is already %s", review.Status))
+	}
+	return libs_errors.Conflict(libs_errors.CodeAlreadyDecided, "reviewer has already decided")
+}
+
+// reviewOutcome is the status the reviewers' decisions so far lead to.
+func reviewOutcome(reviewers []entity.Reviewer) entity.ReviewStatus {
+	for _, reviewer := range reviewers {
+		if reviewer.Decision == entity.ReviewDecisionRejected {
+			return entity.ReviewStatusRejected
+		}
+	}
+	if allApproved(reviewers) {
+		return entity.ReviewStatusApproved
+	}
+	return entity.ReviewStatusPending
+}
+
+// publishApproved publishes the draft of a review this request approved.
+// A draft that cannot be published closes the review as stale, so the
+// current draft can be submitted again.
+func (u *reviewUseCase) publishApproved(ctx context.Context, review *entity.ReviewRequest, userID string, now time.Time) error {
+	published, err := u.publishReviewed(ctx, review, userID, now)
+	if err == nil && published {
+		return nil
+	}
+
+	if _, staleErr := u.reviewRepo.Resolve(ctx, review.ID, entity.ReviewStatusApproved, entity.ReviewStatusStale, now); staleErr != nil && err == nil {
+		err = staleErr
+	}
+	if err != nil {
+		return err
+	}
+	return libs_errors.Conflict(libs_errors.CodeReviewStale, "translation was modified after the review was submitted")
+}
+
+// publishReviewed publishes the reviewed draft. It reports false without
+// publishing when the translation was edited or removed after the review
+// was submitted; edits elsewhere in the wiki do not matter.
+func (u *reviewUseCase) publishReviewed(ctx context.Context, review *entity.ReviewRequest, userID string, now time.Time) (bool, error) {
+	wiki, err := u.wikiRepo.GetWikiByID(ctx, review.WikiID)
+	if err != nil {
+		return false, err
+	}
+
+	if wiki == nil {
+		return false, libs_errors.NotFound(libs_errors.CodeWikiNotFound, "wiki not found")
+	}
+
+	translation := findTranslation(wiki, review.Language)
+	if translation == nil {
+		return false, nil
+	}
+
+	revision, err := translationRevision(translation)
+	if err != nil {
+		return false, libs_errors.Internal("failed to fingerprint translation", err)
+	}
+	if revision != review.Revision {
+		return false, nil
+	}
+
+	before := flattenWiki(wiki)
+	publishTranslation(translation, userID, now)
+	wiki.UpdatedAt = now
+	wiki.UpdatedBy = userID
+
+	if err := u.wikiRepo.UpdateWiki(ctx, wiki.ID, wiki); err != nil {
+		return false, err
+	}
+
+	entry := newWikiAuditEntry(entity.AuditActionWikiPublish, userID, wiki, &review.Language)
+	entry.Changes = diffFields(before, flattenWiki(wiki))
+	recordAudit(ctx, u.auditRepo, entry)
+
+	return true, nil
+}
+
+// translationRevision fingerprints the working copy of a translation, so a
+// review can tell whether the draft it approved is still the current one.
+// Elements are fingerprinted in their migrated form, so migrating stored
+// payloads does not change the revision.
+func translationRevision(translation *entity.Translation) (string, error) {
+	elements := make([]entity.Element, len(translation.Elements))
+	for i, element := range translation.Elements {
+		element.MigratePayload()
+		elements[i] = element
+	}
+
+	raw, err := bson.Marshal(bson.D{
+		{Key: "title", Value: translation.Title},
+		{Key: "keywords", Value: translation.Keywords},
+		{Key: "level", Value: translation.Level},
+		{Key: "unit", Value: translation.Unit},
+		{Key: "elements", Value: elements},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (u *reviewUseCase) resolveReviewers(ctx context.Context, reviewerIDs []string, submitterID string) ([]entity.Reviewer, error) {
+	seen := make(map[string]bool)
+	reviewers := make([]entity.Reviewer, 0, len(reviewerIDs))
+
+	for _, reviewerID := range reviewerIDs {
+		reviewerID = strings.TrimSpace(reviewerID)
+		if reviewerID == "" || seen[reviewerID] {
+			continue
+		}
+		seen[reviewerID] = true
+
+		if reviewerID == submitterID {
+			return nil, libs_errors.InvalidField("reviewer_ids", libs_errors.FieldSelfReview, "submitter cannot review their own translation", nil)
+		}
+
+		user, err := u.userGateway.GetUserByID(ctx, reviewerID)
+		if err != nil || user == nil {
+			return nil, libs_errors.InvalidField("reviewer_ids", libs_errors.FieldUserNotFound, fmt.Sprintf("reviewer not found: %s", reviewerID), map[string]interface{}{"user_id": reviewerID})
+		}
+
+		name := user.Fullname
+		if name == "" {
+			name = user.Username
+		}
+
+		reviewers = append(reviewers, entity.Reviewer{
+			UserID:   user.ID,
+			Name:     name,
+			Email:    user.Email,
+			Decision: entity.ReviewDecisionNone,
+		})
+	}
+
+	if len(reviewers) == 0 {
+		return nil, libs_errors.InvalidField("reviewer_ids", libs_errors.FieldRequired, "at least one reviewer is required", nil)
+	}
+
+	return reviewers, nil
+}
+
+func (u *reviewUseCase) getReview(ctx context.Context, id string) (*entity.ReviewRequest, error) {
+	if id == "" {
+		return nil, libs_errors.Required("id")
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, libs_errors.InvalidID("id")
+	}
+
+	review, err := u.reviewRepo.GetByID(ctx, objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if review == nil {
+		return nil, libs_errors.NotFound(libs_errors.CodeReviewNotFound, "review not found")
+	}
+
+	return review, nil
+}
+
+func allApproved(reviewers []entity.Reviewer) bool {
+	for _, reviewer := range reviewers {
+		if reviewer.Decision != entity.ReviewDecisionApproved {
+			return false
+		}
+	}
+	return len(reviewers) > 0
+}
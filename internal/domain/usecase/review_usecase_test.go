@@ -0,0 +1,346 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/interface/http/dto/request"
+	libs_errors "wiki-service/pkg/libs/errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// reviewedWiki has a published English translation (1) with a newer draft
+// holding a legacy title element, and a Vietnamese translation (2) that is
+// only a draft.
+func reviewedWiki() *entity.Wiki {
+	return &entity.Wiki{
+		ID:        primitive.NewObjectID(),
+		Type:      "wiki_web",
+		Code:      "W1",
+		UpdatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Translation: []entity.Translation{
+			{
+				Language: intPtr(1),
+				Title:    stringPtr("Draft title"),
+				Status:   entity.TranslationStatusDraft,
+				Elements: []entity.Element{
+					{Number: 1, Type: "text", Value: stringPtr("draft text")},
+					{Number: 2, Type: "title", Value: stringPtr(`{"title":"Heading","image_key":"img/a.png"}`)},
+				},
+				Published: &entity.PublishedTranslation{
+					Title:    stringPtr("Live title"),
+					Elements: []entity.Element{{Number: 1, Type: "text", Value: stringPtr("live text")}},
+				},
+			},
+			{
+				Language: intPtr(2),
+				Title:    stringPtr("Tieu de"),
+				Status:   entity.TranslationStatusDraft,
+			},
+		},
+	}
+}
+
+func TestReviewDecision(t *testing.T) {
+	tests := []struct {
+		name string
+		// edit changes the stored wiki between submission and decision
+		edit               func(wiki *entity.Wiki)
+		reject             bool
+		wantCode           string
+		wantStatus         entity.ReviewStatus
+		wantPublishedTitle string
+	}{
+		{
+			name:               "approval publishes the reviewed draft",
+			wantStatus:         entity.ReviewStatusApproved,
+			wantPublishedTitle: "Draft title",
+		},
+		{
+			name: "edits to other translations do not block approval",
+			edit: func(wiki *entity.Wiki) {
+				wiki.Translation[1].Title = stringPtr("Tieu de moi")
+				wiki.UpdatedAt = wiki.UpdatedAt.Add(time.Hour)
+			},
+			wantStatus:         entity.ReviewStatusApproved,
+			wantPublishedTitle: "Draft title",
+		},
+		{
+			name: "migrating stored payloads does not block approval",
+			edit: func(wiki *entity.Wiki) {
+				for i := range wiki.Translation[0].Elements {
+					wiki.Translation[0].Elements[i].MigratePayload()
+				}
+			},
+			wantStatus:         entity.ReviewStatusApproved,
+			wantPublishedTitle: "Draft title",
+		},
+		{
+			name: "an edited draft closes the review as stale",
+			edit: func(wiki *entity.Wiki) {
+				wiki.Translation[0].Elements[0].Value = stringPtr("edited text")
+			},
+			wantCode:           libs_errors.CodeReviewStale,
+			wantStatus:         entity.ReviewStatusStale,
+			wantPublishedTitle: "Live title",
+		},
+		{
+			name: "a removed translation closes the review as stale",
+			edit: func(wiki *entity.Wiki) {
+				wiki.Translation = wiki.Translation[1:]
+			},
+			wantCode:   libs_errors.CodeReviewStale,
+			wantStatus: entity.ReviewStatusStale,
+		},
+		{
+			name:               "rejection leaves the published copy alone",
+			reject:             true,
+			wantStatus:         entity.ReviewStatusRejected,
+			wantPublishedTitle: "Live title",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			wiki := reviewedWiki()
+			wikis := newFakeWikiRepo(wiki)
+			reviews := newFakeReviewRepo()
+			uc := NewReviewUseCase(reviews, wikis, &fakeAuditRepo{}, &fakeUserGateway{})
+
+			submitted, err := uc.SubmitReview(ctx, wiki.ID.Hex(), 1, request.SubmitReviewRequest{ReviewerIDs: []string{"reviewer"}}, "author")
+			if err != nil {
+				t.Fatalf("SubmitReview() error = %v", err)
+			}
+
+			if tt.edit != nil {
+				tt.edit(wikis.wikis[wiki.ID])
+			}
+
+			decision := request.ReviewDecisionRequest{Comment: "looks wrong"}
+			if tt.reject {
+				_, err = uc.RejectReview(ctx, submitted.ID, decision, "reviewer")
+			} else {
+				_, err = uc.ApproveReview(ctx, submitted.ID, decision, "reviewer")
+			}
+			if code := errorCode(err); code != tt.wantCode {
+				t.Fatalf("decision error = %v, want code %q", err, tt.wantCode)
+			}
+			if tt.wantCode == "" && err != nil {
+				t.Fatalf("decision error = %v", err)
+			}
+
+			reviewID, _ := primitive.ObjectIDFromHex(submitted.ID)
+			if status := reviews.reviews[reviewID].Status; status != tt.wantStatus {
+				t.Errorf("review status = %q, want %q", status, tt.wantStatus)
+			}
+
+			translation := findTranslation(wikis.wikis[wiki.ID], 1)
+			if tt.wantPublishedTitle == "" {
+				return
+			}
+			if translation == nil || translation.Published == nil || translation.Published.Title == nil {
+				t.Fatalf("translation has no published title")
+			}
+			if title := *translation.Published.Title; title != tt.wantPublishedTitle {
+				t.Errorf("published title = %q, want %q", title, tt.wantPublishedTitle)
+			}
+		})
+	}
+}
+
+func TestSubmitReview(t *testing.T) {
+	tests := []struct {
+		name string
+		// setup prepares the stored wiki and reviews before submitting
+		setup     func(t *testing.T, uc ReviewUseCase, wiki *entity.Wiki)
+		reviewers []string
+		wantCode  string
+	}{
+		{
+			name:      "a draft can be submitted",
+			reviewers: []string{"reviewer"},
+		},
+		{
+			name: "a published translation has nothing to review",
+			setup: func(t *testing.T, uc ReviewUseCase, wiki *entity.Wiki) {
+				wiki.Translation[0].Status = entity.TranslationStatusPublished
+			},
+			reviewers: []string{"reviewer"},
+			wantCode:  libs_errors.CodeNothingToReview,
+		},
+		{
+			name: "a second pending review is refused",
+			setup: func(t *testing.T, uc ReviewUseCase, wiki *entity.Wiki) {
+				if _, err := uc.SubmitReview(context.Background(), wiki.ID.Hex(), 1, request.SubmitReviewRequest{ReviewerIDs: []string{"other"}}, "author"); err != nil {
+					t.Fatalf("first SubmitReview() error = %v", err)
+				}
+			},
+			reviewers: []string{"reviewer"},
+			wantCode:  libs_errors.CodeReviewPending,
+		},
+		{
+			name: "a stale review does not block resubmission",
+			setup: func(t *testing.T, uc ReviewUseCase, wiki *entity.Wiki) {
+				ctx := context.Background()
+				first, err := uc.SubmitReview(ctx, wiki.ID.Hex(), 1, request.SubmitReviewRequest{ReviewerIDs: []string{"other"}}, "author")
+				if err != nil {
+					t.Fatalf("first SubmitReview() error = %v", err)
+				}
+				wiki.Translation[0].Title = stringPtr("Edited title")
+				if _, err := uc.ApproveReview(ctx, first.ID, request.ReviewDecisionRequest{}, "other"); errorCode(err) != libs_errors.CodeReviewStale {
+					t.Fatalf("ApproveReview() error = %v, want stale", err)
+				}
+			},
+			reviewers: []string{"reviewer"},
+		},
+		{
+			name:      "authors cannot review their own translation",
+			reviewers: []string{"author"},
+			wantCode:  libs_errors.CodeValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wiki := reviewedWiki()
+			wikis := newFakeWikiRepo(wiki)
+			uc := NewReviewUseCase(newFakeReviewRepo(), wikis, &fakeAuditRepo{}, &fakeUserGateway{})
+
+			if tt.setup != nil {
+				tt.setup(t, uc, wikis.wikis[wiki.ID])
+			}
+
+			_, err := uc.SubmitReview(context.Background(), wiki.ID.Hex(), 1, request.SubmitReviewRequest{ReviewerIDs: tt.reviewers}, "author")
+			if code := errorCode(err); code != tt.wantCode {
+				t.Fatalf("SubmitReview() error = %v, want code %q", err, tt.wantCode)
+			}
+			if tt.wantCode == "" && err != nil {
+				t.Fatalf("SubmitReview() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestReviewDecisionOrder(t *testing.T) {
+	type step struct {
+		reviewer string
+		reject   bool
+	}
+
+	tests := []struct {
+		name          string
+		first, second step
+		// interleave runs the first decision while the second is about to
+		// make this write; empty runs them one after the other
+		interleave     string
+		wantSecondCode string
+		wantStatus     entity.ReviewStatus
+		wantPublishes  int
+	}{
+		{
+			name:           "a reviewer cannot decide twice",
+			first:          step{reviewer: "a"},
+			second:         step{reviewer: "a"},
+			wantSecondCode: libs_errors.CodeAlreadyDecided,
+			wantStatus:     entity.ReviewStatusPending,
+		},
+		{
+			name:          "the last approval publishes",
+			first:         step{reviewer: "a"},
+			second:        step{reviewer: "b"},
+			wantStatus:    entity.ReviewStatusApproved,
+			wantPublishes: 1,
+		},
+		{
+			name:           "a rejection closes the review",
+			first:          step{reviewer: "a", reject: true},
+			second:         step{reviewer: "b"},
+			wantSecondCode: libs_errors.CodeReviewClosed,
+			wantStatus:     entity.ReviewStatusRejected,
+		},
+		{
+			name:           "an approval racing a rejection does not reopen the review",
+			first:          step{reviewer: "a", reject: true},
+			second:         step{reviewer: "b"},
+			interleave:     "record",
+			wantSecondCode: libs_errors.CodeReviewClosed,
+			wantStatus:     entity.ReviewStatusRejected,
+		},
+		{
+			name:           "a reviewer deciding twice at once is recorded once",
+			first:          step{reviewer: "a"},
+			second:         step{reviewer: "a", reject: true},
+			interleave:     "record",
+			wantSecondCode: libs_errors.CodeAlreadyDecided,
+			wantStatus:     entity.ReviewStatusPending,
+		},
+		{
+			name:       "a review resolved concurrently is resolved once",
+			first:      step{reviewer: "a"},
+			second:     step{reviewer: "b", reject: true},
+			interleave: "resolve",
+			wantStatus: entity.ReviewStatusRejected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			wiki := reviewedWiki()
+			wikis := newFakeWikiRepo(wiki)
+			reviews := newFakeReviewRepo()
+			audit := &fakeAuditRepo{}
+			uc := NewReviewUseCase(reviews, wikis, audit, &fakeUserGateway{})
+
+			submitted, err := uc.SubmitReview(ctx, wiki.ID.Hex(), 1, request.SubmitReviewRequest{ReviewerIDs: []string{"a", "b"}}, "author")
+			if err != nil {
+				t.Fatalf("SubmitReview() error = %v", err)
+			}
+
+			decide := func(s step) error {
+				decision := request.ReviewDecisionRequest{Comment: "comment"}
+				if s.reject {
+					_, err := uc.RejectReview(ctx, submitted.ID, decision, s.reviewer)
+					return err
+				}
+				_, err := uc.ApproveReview(ctx, submitted.ID, decision, s.reviewer)
+				return err
+			}
+
+			if tt.interleave == "" {
+				if err := decide(tt.first); err != nil {
+					t.Fatalf("first decision error = %v", err)
+				}
+			} else {
+				reviews.interleave = map[string]func(){tt.interleave: func() {
+					if err := decide(tt.first); err != nil {
+						t.Fatalf("first decision error = %v", err)
+					}
+				}}
+			}
+
+			err = decide(tt.second)
+			if code := errorCode(err); code != tt.wantSecondCode || (code == "" && err != nil) {
+				t.Fatalf("second decision error = %v, want code %q", err, tt.wantSecondCode)
+			}
+
+			reviewID, _ := primitive.ObjectIDFromHex(submitted.ID)
+			if status := reviews.reviews[reviewID].Status; status != tt.wantStatus {
+				t.Errorf("review status = %q, want %q", status, tt.wantStatus)
+			}
+
+			publishes := 0
+			for _, action := range audit.actions() {
+				if action == entity.AuditActionWikiPublish {
+					publishes++
+				}
+			}
+			if publishes != tt.wantPublishes {
+				t.Errorf("publishes = %d, want %d", publishes, tt.wantPublishes)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"wiki-service/pkg/gateway"
+	file_gateway_dto "wiki-service/pkg/gateway/dto/file"
+	media_gateway_dto "wiki-service/pkg/gateway/dto/media"
+)
+
+// urlMemo shares URL lookups across the wikis of one read. Each distinct
+// key is fetched once; callers asking for a key already in flight wait for
+// that call instead of making their own.
+type urlMemo struct {
+	mu    sync.Mutex
+	calls map[string]*urlCall
+}
+
+type urlCall struct {
+	done chan struct{}
+	url  *string
+	err  error
+}
+
+func newURLMemo() *urlMemo {
+	return &urlMemo{calls: make(map[string]*urlCall)}
+}
+
+func (m *urlMemo) get(key string, fetch func() (*string, error)) (*string, error) {
+	m.mu.Lock()
+	if call, ok := m.calls[key]; ok {
+		m.mu.Unlock()
+		<-call.done
+		return call.url, call.err
+	}
+	call := &urlCall{done: make(chan struct{})}
+	m.calls[key] = call
+	m.mu.Unlock()
+
+	call.url, call.err = fetch()
+	close(call.done)
+	return call.url, call.err
+}
+
+// sharedFileGateway resolves file URLs through a urlMemo; everything else
+// goes straight to the wrapped gateway.
+type sharedFileGateway struct {
+	gateway.FileGateway
+	memo *urlMemo
+}
+
+func (g *sharedFileGateway) GetImageUrl(ctx context.Context, req file_gateway_dto.GetFileUrlRequest) (*string, error) {
+	return g.memo.get("image:"+req.Mode+":"+req.Key, func() (*string, error) {
+		return g.FileGateway.GetImageUrl(ctx, req)
+	})
+}
+
+func (g *sharedFileGateway) GetVideoUrl(ctx context.Context, req file_gateway_dto.GetFileUrlRequest) (*string, error) {
+	return g.memo.get("video:"+req.Mode+":"+req.Key, func() (*string, error) {
+		return g.FileGateway.GetVideoUrl(ctx, req)
+	})
+}
+
+func (g *sharedFileGateway) GetAudioUrl(ctx context.Context, req file_gateway_dto.GetFileUrlRequest) (*string, error) {
+	return g.memo.get("audio:"+req.Mode+":"+req.Key, func() (*string, error) {
+		return g.FileGateway.GetAudioUrl(ctx, req)
+	})
+}
+
+func (g *sharedFileGateway) GetPDFUrl(ctx context.Context, req file_gateway_dto.GetFileUrlRequest) (*string, error) {
+	return g.memo.get("pdf:"+req.Mode+":"+req.Key, func() (*string, error) {
+		return g.FileGateway.GetPDFUrl(ctx, req)
+	})
+}
+
+// sharedMediaGateway resolves video URLs through a urlMemo.
+type sharedMediaGateway struct {
+	gateway.MediaGateway
+	memo *urlMemo
+}
+
+func (g *sharedMediaGateway) GetVideoUrl(ctx context.Context, req media_gateway_dto.GetVideoUrlRequest) (*string, error) {
+	language := ""
+	if req.Language != nil {
+		language = strconv.Itoa(*req.Language)
+	}
+	return g.memo.get("media:"+language+":"+req.VideoID, func() (*string, error) {
+		return g.MediaGateway.GetVideoUrl(ctx, req)
+	})
+}
+
+// sharedGateways wraps the use case's gateways in one urlMemo for a single
+// read. A nil gateway stays nil so the mapper still skips it.
+func (u *wikiUseCase) sharedGateways() (gateway.FileGateway, gateway.MediaGateway) {
+	memo := newURLMemo()
+
+	var fileGateway gateway.FileGateway
+	if u.fileGateway != nil {
+		fileGateway = &sharedFileGateway{FileGateway: u.fileGateway, memo: memo}
+	}
+	var mediaGateway gateway.MediaGateway
+	if u.mediaGateway != nil {
+		mediaGateway = &sharedMediaGateway{MediaGateway: u.mediaGateway, memo: memo}
+	}
+	return fileGateway, mediaGateway
+}
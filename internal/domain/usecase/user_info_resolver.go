@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+	"wiki-service/internal/interface/http/dto/response.go"
+	"wiki-service/pkg/gateway"
+)
+
+const (
+	userInfoCacheTTL        = 5 * time.Minute
+	userInfoFailureTTL      = 30 * time.Second
+	userInfoCacheMaxEntries = 1000
+	userInfoFetchWorkers    = 8
+)
+
+type cachedUserInfo struct {
+	userID    string
+	info      *response.CreatedByUserInfo
+	expiresAt time.Time
+}
+
+// userInfoResolver turns user IDs into display info. Lookups are
+// de-duplicated per call, fetched concurrently through UserGateway and kept
+// in a short-lived in-process cache. The gateway does not tell a missing
+// account from a failed call, so failures are only cached briefly: long
+// enough to spare a struggling user service, short enough that a blip does
+// not blank creators for long. The cache holds at most
+// userInfoCacheMaxEntries users, evicting the least recently used.
+type userInfoResolver struct {
+	userGateway gateway.UserGateway
+	mu          sync.Mutex
+	cache       map[string]*list.Element
+	recent      *list.List
+}
+
+func newUserInfoResolver(userGateway gateway.UserGateway) *userInfoResolver {
+	return &userInfoResolver{
+		userGateway: userGateway,
+		cache:       make(map[string]*list.Element),
+		recent:      list.New(),
+	}
+}
+
+func (r *userInfoResolver) Resolve(ctx context.Context, userIDs []string) map[string]*response.CreatedByUserInfo {
+	result := make(map[string]*response.CreatedByUserInfo, len(userIDs))
+	now := time.Now()
+
+	var missing []string
+	r.mu.Lock()
+	for _, userID := range userIDs {
+		if userID == "" {
+			continue
+		}
+		if _, done := result[userID]; done {
+			continue
+		}
+		if info, ok := r.cached(userID, now); ok {
+			result[userID] = info
+			continue
+		}
+		result[userID] = nil
+		missing = append(missing, userID)
+	}
+	r.mu.Unlock()
+
+	if len(missing) == 0 {
+		return result
+	}
+
+	fetched := make([]*response.CreatedByUserInfo, len(missing))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < userInfoFetchWorkers && w < len(missing); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				user, err := r.userGateway.GetUserByID(ctx, missing[i])
+				if err != nil || user == nil {
+					continue
+				}
+				fetched[i] = &response.CreatedByUserInfo{
+					ID:       user.ID,
+					Username: user.Username,
+					Nickname: user.Nickname,
+					Fullname: user.Fullname,
+					Email:    user.Email,
+					Avatar:   user.AvatarURL,
+				}
+			}
+		}()
+	}
+	for i := range missing {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	r.mu.Lock()
+	for i, userID := range missing {
+		result[userID] = fetched[i]
+		ttl := userInfoCacheTTL
+		if fetched[i] == nil {
+			ttl = userInfoFailureTTL
+		}
+		r.store(userID, fetched[i], now.Add(ttl))
+	}
+	r.mu.Unlock()
+
+	return result
+}
+
+// cached returns a live cache entry and marks it recently used. Expired
+// entries are dropped. The caller holds r.mu.
+func (r *userInfoResolver) cached(userID string, now time.Time) (*response.CreatedByUserInfo, bool) {
+	element, ok := r.cache[userID]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*cachedUserInfo)
+	if !now.Before(entry.expiresAt) {
+		r.recent.Remove(element)
+		delete(r.cache, userID)
+		return nil, false
+	}
+
+	r.recent.MoveToFront(element)
+	return entry.info, true
+}
+
+// store caches info for a user, evicting the least recently used entries
+// beyond the size cap. The caller holds r.mu.
+func (r *userInfoResolver) store(userID string, info *response.CreatedByUserInfo, expiresAt time.Time) {
+	if element, ok := r.cache[userID]; ok {
+		entry := element.Value.(*cachedUserInfo)
+		entry.info = info
+		entry.expiresAt = expiresAt
+		r.recent.MoveToFront(element)
+		return
+	}
+
+	r.cache[userID] = r.recent.PushFront(&cachedUserInfo{userID: userID, info: info, expiresAt: expiresAt})
+	for r.recent.Len() > userInfoCacheMaxEntries {
+		oldest := r.recent.Back()
+		r.recent.Remove(oldest)
+		delete(r.cache, oldest.Value.(*cachedUserInfo).userID)
+	}
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/interface/http/dto/request"
+	"wiki-service/internal/interface/http/dto/response.go"
+	libs_errors "wiki-service/pkg/libs/errors"
+)
+
+// maxBatchCodes caps the codes one batch request may ask for.
+const maxBatchCodes = 100
+
+// GetWikisByCodes is GetWikiByCode for many codes at once: the wikis are
+// loaded in one query, the template once, and URLs shared between wikis are
+// resolved once. Results follow the order of the requested codes; codes
+// without a wiki are listed as missing rather than failing the request.
+func (u *wikiUseCase) GetWikisByCodes(ctx context.Context, req request.BatchWikisRequest, draft bool) (*response.BatchWikisResponse, error) {
+	if req.Type == "" {
+		return nil, libs_errors.Required("type")
+	}
+
+	codes := make([]string, 0, len(req.Codes))
+	seen := make(map[string]bool, len(req.Codes))
+	for _, code := range req.Codes {
+		code = strings.TrimSpace(code)
+		if code != "" && !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+
+	if len(codes) == 0 {
+		return nil, libs_errors.Required("codes")
+	}
+
+	if len(codes) > maxBatchCodes {
+		return nil, libs_errors.InvalidField("codes", libs_errors.FieldTooMany, fmt.Sprintf("codes accepts at most %d items", maxBatchCodes), map[string]interface{}{"max": maxBatchCodes})
+	}
+
+	found, err := u.wikiRepo.GetWikisByCodes(ctx, codes, req.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	templateWiki, err := u.wikiRepo.GetTemplates(ctx, "wiki_web")
+	if err != nil {
+		return nil, err
+	}
+
+	if templateWiki == nil {
+		return nil, libs_errors.NotFound(libs_errors.CodeTemplateNotFound, "template wiki not found")
+	}
+
+	byCode := make(map[string]*entity.Wiki, len(found))
+	for _, wiki := range found {
+		byCode[wiki.Code] = wiki
+	}
+
+	wikis := make([]*entity.Wiki, 0, len(codes))
+	missing := []string{}
+	for _, code := range codes {
+		if wiki, ok := byCode[code]; ok {
+			wikis = append(wikis, wiki)
+		} else {
+			missing = append(missing, code)
+		}
+	}
+
+	if !draft {
+		applyPublishedView(wikis)
+	}
+
+	var resolutions []*response.LanguageResolutionResponse
+	if req.Language != nil {
+		resolutions, err = u.filterTranslations(ctx, wikis, *req.Language, templateWiki.Elements)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	responses := u.wikisToResponses(ctx, wikis, nil)
+	attachLanguageResolutions(responses, resolutions)
+
+	return &response.BatchWikisResponse{Wikis: responses, Missing: missing}, nil
+}
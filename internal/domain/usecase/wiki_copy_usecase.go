@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/interface/http/dto/request"
+	"wiki-service/internal/interface/http/dto/response.go"
+	libs_errors "wiki-service/pkg/libs/errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Reasons reported for wikis skipped by a bulk copy.
+const (
+	copySkipSourceMissing = "source translation not found"
+	copySkipTargetExists  = "target translation already exists"
+	copySkipModified      = "wiki was modified during the copy"
+)
+
+// CopyTranslation starts the target language of a wiki from an existing
+// translation, so editors only need to translate instead of rebuilding
+// every element and re-attaching media.
+func (u *wikiUseCase) CopyTranslation(ctx context.Context, id string, language, sourceLanguage int, req request.CopyTranslationRequest, userID string) error {
+	if id == "" {
+		return libs_errors.Required("id")
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return libs_errors.InvalidID("id")
+	}
+
+	if err := u.validateCopyLanguages(ctx, language, sourceLanguage); err != nil {
+		return err
+	}
+
+	wiki, err := u.wikiRepo.GetWikiByID(ctx, objectID)
+	if err != nil {
+		return err
+	}
+
+	if wiki == nil {
+		return libs_errors.NotFound(libs_errors.CodeWikiNotFound, "wiki not found")
+	}
+
+	before := flattenWiki(wiki)
+
+	switch copyTranslation(wiki, language, sourceLanguage, req) {
+	case copySkipSourceMissing:
+		return libs_errors.NotFound(libs_errors.CodeTranslationNotFound, "source translation not found")
+	case copySkipTargetExists:
+		return libs_errors.Conflict(libs_errors.CodeTranslationExists, "target translation already exists")
+	}
+
+	return u.saveWikiCopy(ctx, objectID, wiki, before, userID, language)
+}
+
+// CopyTranslations runs CopyTranslation over every wiki of a type. Wikis
+// that cannot be copied are reported instead of failing the whole run; each
+// write is conditional on updated_at so concurrent edits are never lost.
+func (u *wikiUseCase) CopyTranslations(ctx context.Context, typeParam string, language, sourceLanguage int, req request.CopyTranslationRequest, userID string) (*response.CopyTranslationsResponse, error) {
+	if typeParam == "" {
+		return nil, libs_errors.Required("type")
+	}
+
+	if err := u.validateCopyLanguages(ctx, language, sourceLanguage); err != nil {
+		return nil, err
+	}
+
+	result := &response.CopyTranslationsResponse{Skipped: []response.CopySkippedResponse{}}
+
+	err := u.wikiRepo.IterateWikis(ctx, typeParam, func(wiki *entity.Wiki) error {
+		before := flattenWiki(wiki)
+		readAt := wiki.UpdatedAt
+
+		if reason := copyTranslation(wiki, language, sourceLanguage, req); reason != "" {
+			result.Skipped = append(result.Skipped, response.CopySkippedResponse{Code: wiki.Code, Reason: reason})
+			return nil
+		}
+
+		wiki.UpdatedAt = time.Now()
+		wiki.UpdatedBy = userID
+
+		updated, err := u.wikiRepo.UpdateWikiIfUnchanged(ctx, wiki, readAt)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			result.Skipped = append(result.Skipped, response.CopySkippedResponse{Code: wiki.Code, Reason: copySkipModified})
+			return nil
+		}
+
+		u.auditCopy(ctx, wiki, before, userID, language)
+		result.Copied++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (u *wikiUseCase) validateCopyLanguages(ctx context.Context, language, sourceLanguage int) error {
+	if language == sourceLanguage {
+		return libs_errors.InvalidField("source_language", libs_errors.FieldMustDiffer,
+			"source language must differ from the target language", map[string]interface{}{"other": "language"})
+	}
+
+	_, err := u.languages.Enabled(ctx, "language", language)
+	return err
+}
+
+func (u *wikiUseCase) saveWikiCopy(ctx context.Context, objectID primitive.ObjectID, wiki *entity.Wiki, before map[string]interface{}, userID string, language int) error {
+	wiki.UpdatedAt = time.Now()
+	wiki.UpdatedBy = userID
+
+	if err := u.wikiRepo.UpdateWiki(ctx, objectID, wiki); err != nil {
+		return err
+	}
+
+	u.auditCopy(ctx, wiki, before, userID, language)
+	return nil
+}
+
+func (u *wikiUseCase) auditCopy(ctx context.Context, wiki *entity.Wiki, before map[string]interface{}, userID string, language int) {
+	entry := newWikiAuditEntry(entity.AuditActionWikiCopy, userID, wiki, &language)
+	entry.Changes = diffFields(before, flattenWiki(wiki))
+	recordAudit(ctx, u.auditRepo, entry)
+}
+
+// copyTranslation writes the working copy of language from sourceLanguage
+// in memory, returning a skip reason when it cannot. Publication state and
+// schedules of an existing target are kept; the copy is always a draft.
+func copyTranslation(wiki *entity.Wiki, language, sourceLanguage int, req request.CopyTranslationRequest) string {
+	source := findTranslation(wiki, sourceLanguage)
+	if source == nil {
+		return copySkipSourceMissing
+	}
+
+	target := findTranslation(wiki, language)
+	if target != nil && !req.Overwrite {
+		return copySkipTargetExists
+	}
+	if target != nil {
+		freezeLegacyContent(wiki, target)
+	}
+
+	// Snapshot the source before the slice may grow and move.
+	copied := entity.Translation{
+		Level:    source.Level,
+		Unit:     source.Unit,
+		Elements: make([]entity.Element, len(source.Elements)),
+	}
+	if req.IncludeText {
+		copied.Title = source.Title
+		copied.Keywords = source.Keywords
+	}
+	for i, elem := range source.Elements {
+		copied.Elements[i] = copyElement(elem, req.IncludeText)
+	}
+
+	if target == nil {
+		target = untranslatedSeed(wiki)
+	}
+	if target == nil {
+		wiki.Translation = append(wiki.Translation, entity.Translation{})
+		target = &wiki.Translation[len(wiki.Translation)-1]
+	}
+
+	lang := language
+	target.Language = &lang
+	target.Title = copied.Title
+	target.Keywords = copied.Keywords
+	target.Level = copied.Level
+	target.Unit = copied.Unit
+	target.Elements = copied.Elements
+	target.Status = entity.TranslationStatusDraft
+	return ""
+}
+
+// copyElement clones an element keeping its structure and media keys. Text
+// is only kept when includeText is set; payloads that mix both (titles,
+// buttons, links) keep their image, icon, code and URL and lose their title.
+// Elements not yet migrated are copied in their migrated form.
+func copyElement(elem entity.Element, includeText bool) entity.Element {
+	elem.MigratePayload()
+	copied := elem
+
+	if len(elem.PictureKeys) > 0 {
+		copied.PictureKeys = make([]entity.PictureItem, len(elem.PictureKeys))
+		for i, item := range elem.PictureKeys {
+			copied.PictureKeys[i] = item
+			if !includeText {
+				copied.PictureKeys[i].Title = nil
+			}
+		}
+	}
+
+	if includeText {
+		return copied
+	}
+
+	if elem.Title != nil {
+		copied.Title = nil
+		if elem.Title.ImageKey != "" {
+			copied.Title = &entity.TitlePayload{ImageKey: elem.Title.ImageKey}
+		}
+	}
+	if elem.Button != nil {
+		button := *elem.Button
+		button.Title = ""
+		copied.Button = &button
+	}
+	if elem.Link != nil {
+		link := *elem.Link
+		link.Title = ""
+		copied.Link = &link
+	}
+	if !entity.IsMediaElementType(elem.Type) {
+		copied.Value = nil
+	}
+	return copied
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/interface/http/dto/response.go"
+	libs_search "wiki-service/pkg/libs/search"
+)
+
+const (
+	// highlightLength is the longest snippet in runes, ellipses aside
+	highlightLength = 160
+
+	// maxElementHighlights caps the element snippets per translation
+	maxElementHighlights = 3
+)
+
+// attachHighlights adds to each response the snippets where search matched
+// its translations, as served: the title, the keywords and the first few
+// matching elements.
+func attachHighlights(responses []*response.WikiResponse, wikis []*entity.Wiki, search string) {
+	terms := libs_search.Terms(search)
+	if len(terms) == 0 {
+		return
+	}
+
+	for i, resp := range responses {
+		if resp == nil || i >= len(wikis) || wikis[i] == nil {
+			continue
+		}
+		for _, translation := range wikis[i].Translation {
+			if translation.Language != nil {
+				resp.Highlights = append(resp.Highlights, translationHighlights(translation, terms)...)
+			}
+		}
+	}
+}
+
+func translationHighlights(translation entity.Translation, terms []string) []response.HighlightResponse {
+	language := *translation.Language
+	var highlights []response.HighlightResponse
+
+	highlight := func(field string, element *int, text string) bool {
+		snippet, matches, ok := libs_search.Highlight(text, terms, highlightLength)
+		if !ok {
+			return false
+		}
+
+		ranges := make([]response.MatchRangeResponse, len(matches))
+		for i, match := range matches {
+			ranges[i] = response.MatchRangeResponse{Start: match.Start, End: match.End}
+		}
+		highlights = append(highlights, response.HighlightResponse{
+			Language: language,
+			Field:    field,
+			Element:  element,
+			Snippet:  snippet,
+			Matches:  ranges,
+		})
+		return true
+	}
+
+	if translation.Title != nil {
+		highlight(entity.WikiFieldTitle, nil, *translation.Title)
+	}
+	if translation.Keywords != nil {
+		highlight(entity.WikiFieldKeywords, nil, *translation.Keywords)
+	}
+
+	elements := 0
+	for _, elem := range translation.Elements {
+		if elements == maxElementHighlights {
+			break
+		}
+		number := elem.Number
+		for _, text := range elem.VisibleText() {
+			if highlight("element", &number, text) {
+				elements++
+				break
+			}
+		}
+	}
+	return highlights
+}
@@ -0,0 +1,360 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/interface/http/dto/request"
+	"wiki-service/internal/interface/http/dto/response.go"
+	libs_errors "wiki-service/pkg/libs/errors"
+)
+
+// GetTranslationProgress reports, for every enabled language (or the one
+// requested), how much of a type has been translated: wikis started and
+// complete, template elements filled, and which elements each code still
+// misses. Progress is measured on the working copies editors see, against
+// the type's template. Level and unit match a wiki when any of its
+// translations carries them.
+func (u *wikiUseCase) GetTranslationProgress(ctx context.Context, req request.TranslationProgressRequest) (*response.TranslationProgressResponse, error) {
+	if req.Type == "" {
+		return nil, libs_errors.Required("type")
+	}
+
+	if req.Page < 1 {
+		return nil, libs_errors.InvalidField("page", libs_errors.FieldMustBePositive, "page must be greater than 0", nil)
+	}
+
+	if req.Limit < 1 {
+		return nil, libs_errors.InvalidField("limit", libs_errors.FieldMustBePositive, "limit must be greater than 0", nil)
+	}
+
+	var languages []*entity.Language
+	if req.Language != nil {
+		language, err := u.languages.Enabled(ctx, "language", *req.Language)
+		if err != nil {
+			return nil, err
+		}
+		languages = []*entity.Language{language}
+	} else {
+		enabled, err := u.languages.EnabledLanguages(ctx)
+		if err != nil {
+			return nil, err
+		}
+		languages = enabled
+	}
+
+	template, err := u.wikiRepo.GetTemplates(ctx, req.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if template == nil {
+		return nil, libs_errors.NotFound(libs_errors.CodeTemplateNotFound, "template wiki not found")
+	}
+
+	elementNumbers := make([]int, 0, len(template.Elements))
+	for _, elem := range template.Elements {
+		elementNumbers = append(elementNumbers, elem.Number)
+	}
+
+	progress := make([]response.LanguageProgressResponse, len(languages))
+	for i, language := range languages {
+		progress[i] = response.LanguageProgressResponse{Language: language.ID, Code: language.Code}
+	}
+
+	result := &response.TranslationProgressResponse{
+		Type:         req.Type,
+		ElementCount: len(elementNumbers),
+	}
+
+	missingTotal := 0
+	skip := (req.Page - 1) * req.Limit
+	items := make([]response.MissingElementsResponse, 0, req.Limit)
+
+	err = u.wikiRepo.IterateWikis(ctx, req.Type, func(wiki *entity.Wiki) error {
+		if !matchesLevelAndUnit(wiki, req.Level, req.Unit) {
+			return nil
+		}
+		result.TotalWikis++
+
+		var missing map[string][]int
+		for i, language := range languages {
+			numbers := missingElements(findTranslation(wiki, language.ID), elementNumbers)
+			filled := len(elementNumbers) - len(numbers)
+
+			progress[i].ElementsFilled += filled
+			if filled > 0 {
+				progress[i].WikisStarted++
+			}
+			if len(numbers) == 0 {
+				progress[i].WikisComplete++
+				continue
+			}
+
+			if missing == nil {
+				missing = make(map[string][]int)
+			}
+			missing[strconv.Itoa(language.ID)] = numbers
+		}
+
+		if missing == nil {
+			return nil
+		}
+		if missingTotal >= skip && len(items) < req.Limit {
+			items = append(items, response.MissingElementsResponse{Code: wiki.Code, Languages: missing})
+		}
+		missingTotal++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range progress {
+		progress[i].ElementsTotal = result.TotalWikis * len(elementNumbers)
+		progress[i].WikisCompletePercent = percent(progress[i].WikisComplete, result.TotalWikis)
+		progress[i].ElementsFilledPercent = percent(progress[i].ElementsFilled, progress[i].ElementsTotal)
+	}
+	result.Languages = progress
+
+	result.Missing = response.MissingElementsPage{
+		Items:      items,
+		Page:       req.Page,
+		Limit:      req.Limit,
+		Total:      missingTotal,
+		TotalPages: (missingTotal + req.Limit - 1) / req.Limit,
+	}
+
+	return result, nil
+}
+
+func matchesLevelAndUnit(wiki *entity.Wiki, level *int, unit string) bool {
+	if level == nil && unit == "" {
+		return true
+	}
+
+	for _, translation := range wiki.Translation {
+		if level != nil && (translation.Level == nil || *translation.Level != *level) {
+			continue
+		}
+		if unit != "" && (translation.Unit == nil || !strings.EqualFold(*translation.Unit, unit)) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// missingElements returns the template element numbers that translation
+// has not filled in; a missing translation misses all of them.
+func missingElements(translation *entity.Translation, elementNumbers []int) []int {
+	filled := make(map[int]bool)
+	if translation != nil {
+		for _, elem := range translation.Elements {
+			if elem.HasContent() {
+				filled[elem.Number] = true
+			}
+		}
+	}
+
+	missing := make([]int, 0)
+	for _, number := range elementNumbers {
+		if !filled[number] {
+			missing = append(missing, number)
+		}
+	}
+	return missing
+}
+
+// percent returns part/total as a percentage rounded to two decimals.
+func percent(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(part)*10000/float64(total)) / 100
+}
+
+// GetTypeSummary returns type-wide completion computed by the database:
+// per enabled language, per template element and the public/private split.
+// Percentages are relative to every non-trashed wiki of the type.
+func (u *wikiUseCase) GetTypeSummary(ctx context.Context, typeParam string) (*response.TypeSummaryResponse, error) {
+	if typeParam == "" {
+		return nil, libs_errors.Required("type")
+	}
+
+	languages, err := u.languages.EnabledLanguages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := u.wikiRepo.GetTemplates(ctx, typeParam)
+	if err != nil {
+		return nil, err
+	}
+
+	if template == nil {
+		return nil, libs_errors.NotFound(libs_errors.CodeTemplateNotFound, "template wiki not found")
+	}
+
+	elementNumbers := make([]int, 0, len(template.Elements))
+	for _, elem := range template.Elements {
+		elementNumbers = append(elementNumbers, elem.Number)
+	}
+
+	summary, err := u.wikiRepo.SummarizeType(ctx, typeParam, elementNumbers)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int]entity.LanguageSummary, len(summary.Languages))
+	for _, language := range summary.Languages {
+		counts[language.Language] = language
+	}
+
+	elementsTotal := summary.TotalWikis * len(elementNumbers)
+	languageResponses := make([]response.LanguageSummaryResponse, 0, len(languages))
+	for _, language := range languages {
+		count := counts[language.ID]
+		languageResponses = append(languageResponses, response.LanguageSummaryResponse{
+			Language:              language.ID,
+			Code:                  language.Code,
+			Wikis:                 count.Wikis,
+			Started:               count.Started,
+			Complete:              count.Complete,
+			CompletePercent:       percent(count.Complete, summary.TotalWikis),
+			ElementsFilled:        count.ElementsFilled,
+			ElementsFilledPercent: percent(count.ElementsFilled, elementsTotal),
+		})
+	}
+
+	filled := make(map[int]map[int]int)
+	for _, elem := range summary.Elements {
+		if filled[elem.Number] == nil {
+			filled[elem.Number] = make(map[int]int)
+		}
+		filled[elem.Number][elem.Language] = elem.Filled
+	}
+
+	elementResponses := make([]response.ElementSummaryResponse, 0, len(template.Elements))
+	for _, elem := range template.Elements {
+		perLanguage := make(map[string]response.ElementLanguageSummaryResponse, len(languages))
+		for _, language := range languages {
+			count := filled[elem.Number][language.ID]
+			perLanguage[strconv.Itoa(language.ID)] = response.ElementLanguageSummaryResponse{
+				Filled:  count,
+				Percent: percent(count, summary.TotalWikis),
+			}
+		}
+		elementResponses = append(elementResponses, response.ElementSummaryResponse{
+			Number:    elem.Number,
+			Type:      elem.Type,
+			Languages: perLanguage,
+		})
+	}
+
+	return &response.TypeSummaryResponse{
+		Type:         typeParam,
+		TotalWikis:   summary.TotalWikis,
+		Public:       summary.Public,
+		Private:      summary.Private,
+		ElementCount: len(elementNumbers),
+		Languages:    languageResponses,
+		Elements:     elementResponses,
+	}, nil
+}
+
+// StatisticsExport is a validated statistics sheet ready to stream: the
+// header is known up front and Rows walks every wiki of the type.
+type StatisticsExport struct {
+	Type   string
+	Header []string
+	rows   func(ctx context.Context, fn func(row []string) error) error
+}
+
+// Rows streams one row per code, in code order, to fn.
+func (e *StatisticsExport) Rows(ctx context.Context, fn func(row []string) error) error {
+	return e.rows(ctx, fn)
+}
+
+// ExportStatistics prepares a spreadsheet of a type's completion: one row
+// per code with its visibility, a filled/total count per enabled language,
+// and a yes/no column for every template element in every language.
+// Validation happens here so errors surface before the response starts
+// streaming.
+func (u *wikiUseCase) ExportStatistics(ctx context.Context, typeParam string) (*StatisticsExport, error) {
+	if typeParam == "" {
+		return nil, libs_errors.Required("type")
+	}
+
+	languages, err := u.languages.EnabledLanguages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := u.wikiRepo.GetTemplates(ctx, typeParam)
+	if err != nil {
+		return nil, err
+	}
+
+	if template == nil {
+		return nil, libs_errors.NotFound(libs_errors.CodeTemplateNotFound, "template wiki not found")
+	}
+
+	elementNumbers := make([]int, 0, len(template.Elements))
+	header := []string{"code", "public"}
+	for _, language := range languages {
+		header = append(header, fmt.Sprintf("%s_filled", language.Code))
+	}
+	for _, elem := range template.Elements {
+		elementNumbers = append(elementNumbers, elem.Number)
+		for _, language := range languages {
+			header = append(header, fmt.Sprintf("%d_%s_%s", elem.Number, elem.Type, language.Code))
+		}
+	}
+
+	rows := func(ctx context.Context, fn func(row []string) error) error {
+		return u.wikiRepo.IterateWikis(ctx, typeParam, func(wiki *entity.Wiki) error {
+			row := make([]string, 0, len(header))
+			row = append(row, wiki.Code, strconv.Itoa(wiki.Public))
+
+			filled := make([]map[int]bool, len(languages))
+			for i, language := range languages {
+				filled[i] = make(map[int]bool)
+				if translation := findTranslation(wiki, language.ID); translation != nil {
+					for _, elem := range translation.Elements {
+						if elem.HasContent() {
+							filled[i][elem.Number] = true
+						}
+					}
+				}
+			}
+
+			for i := range languages {
+				count := 0
+				for _, number := range elementNumbers {
+					if filled[i][number] {
+						count++
+					}
+				}
+				row = append(row, fmt.Sprintf("%d/%d", count, len(elementNumbers)))
+			}
+
+			for _, number := range elementNumbers {
+				for i := range languages {
+					if filled[i][number] {
+						row = append(row, "yes")
+					} else {
+						row = append(row, "no")
+					}
+				}
+			}
+
+			return fn(row)
+		})
+	}
+
+	return &StatisticsExport{Type: typeParam, Header: header, rows: rows}, nil
+}
@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/interface/http/dto/request"
+	libs_errors "wiki-service/pkg/libs/errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// schedulerUserID is recorded as publisher for scheduled publications.
+const schedulerUserID = "scheduler"
+
+func (u *wikiUseCase) ScheduleWiki(ctx context.Context, id string, req request.ScheduleWikiRequest, userID string) error {
+	if id == "" {
+		return libs_errors.Required("id")
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return libs_errors.InvalidID("id")
+	}
+
+	if req.PublishAt != nil && req.UnpublishAt != nil && !req.UnpublishAt.After(*req.PublishAt) {
+		return libs_errors.InvalidField("unpublish_at", libs_errors.FieldMustBeAfter, "unpublish_at must be after publish_at", map[string]interface{}{"other": "publish_at"})
+	}
+
+	wiki, err := u.wikiRepo.GetWikiByID(ctx, objectID)
+	if err != nil {
+		return err
+	}
+
+	if wiki == nil {
+		return libs_errors.NotFound(libs_errors.CodeWikiNotFound, "wiki not found")
+	}
+
+	before := flattenWiki(wiki)
+	readAt := wiki.UpdatedAt
+	now := time.Now()
+
+	if req.Language == nil {
+		wiki.PublishAt = req.PublishAt
+		wiki.UnpublishAt = req.UnpublishAt
+	} else {
+		translation := findTranslation(wiki, *req.Language)
+		if translation == nil {
+			return libs_errors.NotFound(libs_errors.CodeTranslationNotFound, "translation not found")
+		}
+
+		translation.Scheduled = nil
+		if req.PublishAt != nil {
+			if err := u.checkSchedulable(ctx, wiki.ID, translation); err != nil {
+				return err
+			}
+			// Later edits to the draft are not published by this schedule
+			translation.Scheduled = snapshotTranslation(translation, userID, now)
+		}
+		translation.PublishAt = req.PublishAt
+		translation.UnpublishAt = req.UnpublishAt
+	}
+
+	wiki.UpdatedAt = now
+	wiki.UpdatedBy = userID
+
+	updated, err := u.wikiRepo.UpdateWikiIfUnchanged(ctx, wiki, readAt)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return libs_errors.Conflict(libs_errors.CodeWikiModified, "wiki was modified while scheduling")
+	}
+
+	entry := newWikiAuditEntry(entity.AuditActionWikiSchedule, userID, wiki, req.Language)
+	entry.Changes = diffFields(before, flattenWiki(wiki))
+	recordAudit(ctx, u.auditRepo, entry)
+
+	return nil
+}
+
+// checkSchedulable refuses to schedule a draft that has not been reviewed
+// yet, since publishing it later would bypass the review.
+func (u *wikiUseCase) checkSchedulable(ctx context.Context, wikiID primitive.ObjectID, translation *entity.Translation) error {
+	if translation.MachineTranslated != nil {
+		return libs_errors.Conflict(libs_errors.CodeMachineTranslated, "machine translated drafts must be reviewed before they are scheduled")
+	}
+
+	pending, err := u.reviewRepo.GetPendingByTranslation(ctx, wikiID, *translation.Language)
+	if err != nil {
+		return err
+	}
+	if pending != nil {
+		return libs_errors.Conflict(libs_errors.CodeReviewPending, "translation has a pending review")
+	}
+	return nil
+}
+
+// RunDueSchedules applies every publish/unpublish time that has passed. Each
+// wiki is written with an optimistic check on updated_at, so a concurrent
+// edit or another instance winning the race simply skips that wiki until
+// the next run.
+func (u *wikiUseCase) RunDueSchedules(ctx context.Context) error {
+	now := time.Now()
+
+	wikis, err := u.wikiRepo.GetDueSchedules(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to load due schedules: %w", err)
+	}
+
+	for _, wiki := range wikis {
+		previousUpdatedAt := wiki.UpdatedAt
+		before := flattenWiki(wiki)
+		if !applyDueSchedules(wiki, now) {
+			continue
+		}
+		wiki.UpdatedAt = now
+		wiki.UpdatedBy = schedulerUserID
+
+		updated, err := u.wikiRepo.UpdateWikiIfUnchanged(ctx, wiki, previousUpdatedAt)
+		if err != nil {
+			log.Printf("failed to apply schedule for wiki %s: %v", wiki.ID.Hex(), err)
+			continue
+		}
+		if !updated {
+			log.Printf("wiki %s changed while applying schedule, retrying next run", wiki.ID.Hex())
+			continue
+		}
+
+		entry := newWikiAuditEntry(entity.AuditActionWikiScheduled, schedulerUserID, wiki, nil)
+		entry.Changes = diffFields(before, flattenWiki(wiki))
+		recordAudit(ctx, u.auditRepo, entry)
+	}
+
+	return nil
+}
+
+// applyDueSchedules flips the wiki's Public flag and each translation's
+// published state for every time at or before now, reporting whether
+// anything changed.
+func applyDueSchedules(wiki *entity.Wiki, now time.Time) bool {
+	changed := false
+
+	publishDue := wiki.PublishAt != nil && !wiki.PublishAt.After(now)
+	unpublishDue := wiki.UnpublishAt != nil && !wiki.UnpublishAt.After(now)
+	if publishDue {
+		wiki.Public = 1
+		wiki.PublishAt = nil
+		changed = true
+	}
+	// When both are due the later one wins, which the validation in
+	// ScheduleWiki guarantees is the unpublish.
+	if unpublishDue {
+		wiki.Public = 0
+		wiki.UnpublishAt = nil
+		changed = true
+	}
+
+	for i := range wiki.Translation {
+		translation := &wiki.Translation[i]
+
+		if translation.PublishAt != nil && !translation.PublishAt.After(now) {
+			publishScheduled(translation, now)
+			translation.PublishAt = nil
+			changed = true
+		}
+
+		if translation.UnpublishAt != nil && !translation.UnpublishAt.After(now) {
+			translation.Published = nil
+			translation.Status = entity.TranslationStatusDraft
+			translation.UnpublishAt = nil
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// publishScheduled publishes the copy frozen when the translation was
+// scheduled; without one there is nothing to publish. The translation only
+// counts as published when its draft was not edited since, otherwise the
+// newer draft stays unpublished.
+func publishScheduled(translation *entity.Translation, now time.Time) {
+	scheduled := translation.Scheduled
+	if scheduled == nil {
+		return
+	}
+
+	published := *scheduled
+	published.PublishedAt = now
+	published.PublishedBy = schedulerUserID
+	translation.Published = &published
+	translation.Scheduled = nil
+	translation.Status = entity.TranslationStatusDraft
+
+	draftRevision, err := translationRevision(translation)
+	if err != nil {
+		return
+	}
+	frozenRevision, err := translationRevision(&entity.Translation{
+		Title:    scheduled.Title,
+		Keywords: scheduled.Keywords,
+		Level:    scheduled.Level,
+		Unit:     scheduled.Unit,
+		Elements: scheduled.Elements,
+	})
+	if err == nil && draftRevision == frozenRevision {
+		translation.Status = entity.TranslationStatusPublished
+		translation.MachineTranslated = nil
+	}
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+	"wiki-service/internal/domain/entity"
+)
+
+func TestApplyDueSchedules(t *testing.T) {
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	due := now.Add(-time.Minute)
+
+	scheduledDraft := func() entity.Translation {
+		translation := entity.Translation{
+			Language:  intPtr(1),
+			Title:     stringPtr("Scheduled"),
+			Status:    entity.TranslationStatusDraft,
+			PublishAt: &due,
+		}
+		translation.Scheduled = snapshotTranslation(&translation, "editor", due)
+		return translation
+	}
+
+	tests := []struct {
+		name string
+		// edit changes the draft after it was scheduled
+		edit          func(translation *entity.Translation)
+		wantPublished string
+		wantStatus    entity.TranslationStatus
+	}{
+		{
+			name:          "the scheduled draft is published",
+			wantPublished: "Scheduled",
+			wantStatus:    entity.TranslationStatusPublished,
+		},
+		{
+			name: "later edits to the draft are not published",
+			edit: func(translation *entity.Translation) {
+				translation.Title = stringPtr("Edited")
+				translation.MachineTranslated = &entity.MachineTranslation{Provider: "stub"}
+			},
+			wantPublished: "Scheduled",
+			wantStatus:    entity.TranslationStatusDraft,
+		},
+		{
+			name: "without a frozen copy nothing is published",
+			edit: func(translation *entity.Translation) {
+				translation.Scheduled = nil
+			},
+			wantStatus: entity.TranslationStatusDraft,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wiki := &entity.Wiki{Translation: []entity.Translation{scheduledDraft()}}
+			translation := &wiki.Translation[0]
+			if tt.edit != nil {
+				tt.edit(translation)
+			}
+
+			if !applyDueSchedules(wiki, now) {
+				t.Fatalf("applyDueSchedules() reported no change")
+			}
+
+			if translation.PublishAt != nil || translation.Scheduled != nil {
+				t.Errorf("schedule was not cleared")
+			}
+			if translation.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", translation.Status, tt.wantStatus)
+			}
+			if tt.wantPublished == "" {
+				if translation.Published != nil {
+					t.Errorf("published copy = %q, want none", *translation.Published.Title)
+				}
+				return
+			}
+			if translation.Published == nil || *translation.Published.Title != tt.wantPublished {
+				t.Fatalf("published copy = %+v, want title %q", translation.Published, tt.wantPublished)
+			}
+			if translation.Published.PublishedBy != schedulerUserID {
+				t.Errorf("published by = %q, want %q", translation.Published.PublishedBy, schedulerUserID)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/interface/http/dto/request"
+	"wiki-service/internal/interface/http/dto/response.go"
+	libs_errors "wiki-service/pkg/libs/errors"
+)
+
+// defaultTrendRange is how far back GetCompletionTrends looks when no
+// start date is given.
+const defaultTrendRange = 90 * 24 * time.Hour
+
+// SnapshotCompletion stores today's completion for every templated type in
+// every enabled language, using the same counts as the statistics summary.
+// A type that fails does not stop the others from getting their snapshot;
+// the failures are returned together once every type was tried.
+func (u *wikiUseCase) SnapshotCompletion(ctx context.Context) error {
+	now := time.Now().UTC()
+	day := snapshotDay(now)
+
+	languages, err := u.languages.EnabledLanguages(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load languages: %w", err)
+	}
+
+	types, err := u.wikiRepo.GetTemplateTypes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load template types: %w", err)
+	}
+
+	var errs []error
+	for _, typeParam := range types {
+		if err := u.snapshotType(ctx, typeParam, languages, day, now); err != nil {
+			errs = append(errs, fmt.Errorf("failed to snapshot completion for type %s: %w", typeParam, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (u *wikiUseCase) snapshotType(ctx context.Context, typeParam string, languages []*entity.Language, day, now time.Time) error {
+	template, err := u.wikiRepo.GetTemplates(ctx, typeParam)
+	if err != nil {
+		return err
+	}
+
+	if template == nil {
+		return nil
+	}
+
+	elementNumbers := make([]int, 0, len(template.Elements))
+	for _, elem := range template.Elements {
+		elementNumbers = append(elementNumbers, elem.Number)
+	}
+
+	summary, err := u.wikiRepo.SummarizeType(ctx, typeParam, elementNumbers)
+	if err != nil {
+		return err
+	}
+
+	counts := make(map[int]entity.LanguageSummary, len(summary.Languages))
+	for _, language := range summary.Languages {
+		counts[language.Language] = language
+	}
+
+	for _, language := range languages {
+		count := counts[language.ID]
+		snapshot := &entity.CompletionSnapshot{
+			Type:           typeParam,
+			Language:       language.ID,
+			Date:           day,
+			TotalWikis:     summary.TotalWikis,
+			WikisStarted:   count.Started,
+			WikisComplete:  count.Complete,
+			ElementsFilled: count.ElementsFilled,
+			ElementsTotal:  summary.TotalWikis * len(elementNumbers),
+			TakenAt:        now,
+		}
+		if err := u.snapshotRepo.Upsert(ctx, snapshot); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetCompletionTrends returns the daily snapshots of a type as one series
+// per enabled language (or the one requested) between from and to. The
+// range defaults to the last 90 days.
+func (u *wikiUseCase) GetCompletionTrends(ctx context.Context, req request.CompletionTrendsRequest) (*response.CompletionTrendsResponse, error) {
+	if req.Type == "" {
+		return nil, libs_errors.Required("type")
+	}
+
+	to := snapshotDay(time.Now().UTC())
+	if req.To != nil {
+		to = snapshotDay(req.To.UTC())
+	}
+
+	from := to.Add(-defaultTrendRange)
+	if req.From != nil {
+		from = snapshotDay(req.From.UTC())
+	}
+
+	if to.Before(from) {
+		return nil, libs_errors.InvalidField("to", libs_errors.FieldMustBeAfter, "to must be after from", map[string]interface{}{"other": "from"})
+	}
+
+	var languages []*entity.Language
+	if req.Language != nil {
+		language, err := u.languages.Enabled(ctx, "language", *req.Language)
+		if err != nil {
+			return nil, err
+		}
+		languages = []*entity.Language{language}
+	} else {
+		enabled, err := u.languages.EnabledLanguages(ctx)
+		if err != nil {
+			return nil, err
+		}
+		languages = enabled
+	}
+
+	snapshots, err := u.snapshotRepo.GetRange(ctx, req.Type, req.Language, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make(map[int][]response.CompletionPointResponse)
+	for _, snapshot := range snapshots {
+		points[snapshot.Language] = append(points[snapshot.Language], response.CompletionPointResponse{
+			Date:                  snapshot.Date,
+			TotalWikis:            snapshot.TotalWikis,
+			WikisStarted:          snapshot.WikisStarted,
+			WikisComplete:         snapshot.WikisComplete,
+			WikisCompletePercent:  percent(snapshot.WikisComplete, snapshot.TotalWikis),
+			ElementsFilled:        snapshot.ElementsFilled,
+			ElementsTotal:         snapshot.ElementsTotal,
+			ElementsFilledPercent: percent(snapshot.ElementsFilled, snapshot.ElementsTotal),
+		})
+	}
+
+	series := make([]response.CompletionSeriesResponse, 0, len(languages))
+	for _, language := range languages {
+		languagePoints := points[language.ID]
+		if languagePoints == nil {
+			languagePoints = []response.CompletionPointResponse{}
+		}
+
+		item := response.CompletionSeriesResponse{
+			Language: language.ID,
+			Code:     language.Code,
+			Points:   languagePoints,
+		}
+		if len(languagePoints) > 1 {
+			first, last := languagePoints[0], languagePoints[len(languagePoints)-1]
+			item.WikisCompletePercentChange = roundPercent(last.WikisCompletePercent - first.WikisCompletePercent)
+			item.ElementsFilledPercentChange = roundPercent(last.ElementsFilledPercent - first.ElementsFilledPercent)
+		}
+		series = append(series, item)
+	}
+
+	return &response.CompletionTrendsResponse{
+		Type:   req.Type,
+		From:   from,
+		To:     to,
+		Series: series,
+	}, nil
+}
+
+// snapshotDay truncates t to the start of its UTC day, the key snapshots
+// are stored under.
+func snapshotDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func roundPercent(value float64) float64 {
+	return math.Round(value*100) / 100
+}
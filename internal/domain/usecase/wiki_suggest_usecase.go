@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"wiki-service/internal/interface/http/dto/request"
+	"wiki-service/internal/interface/http/dto/response.go"
+	libs_errors "wiki-service/pkg/libs/errors"
+)
+
+// maxSuggestions caps the suggestions returned for one query; larger limits
+// are clamped rather than rejected.
+const maxSuggestions = 50
+
+// SuggestWikis returns autocomplete matches for a partial code, title or
+// keyword in one language.
+func (u *wikiUseCase) SuggestWikis(ctx context.Context, req request.SuggestWikisRequest) ([]*response.WikiSuggestionResponse, error) {
+	if req.Type == "" {
+		return nil, libs_errors.Required("type")
+	}
+
+	if strings.TrimSpace(req.Query) == "" {
+		return nil, libs_errors.Required("q")
+	}
+
+	if req.Limit < 1 {
+		return nil, libs_errors.InvalidField("limit", libs_errors.FieldMustBePositive, "limit must be greater than 0", nil)
+	}
+	limit := req.Limit
+	if limit > maxSuggestions {
+		limit = maxSuggestions
+	}
+
+	if _, err := u.languages.Enabled(ctx, "language", req.Language); err != nil {
+		return nil, err
+	}
+
+	suggestions, err := u.wikiRepo.SuggestWikis(ctx, req.Type, req.Query, req.Language, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*response.WikiSuggestionResponse, len(suggestions))
+	for i, suggestion := range suggestions {
+		responses[i] = &response.WikiSuggestionResponse{
+			ID:       suggestion.WikiID.Hex(),
+			Code:     suggestion.Code,
+			Language: suggestion.Language,
+			Title:    suggestion.Title,
+		}
+	}
+	return responses, nil
+}
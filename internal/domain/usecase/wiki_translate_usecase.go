@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/interface/http/dto/request"
+	libs_errors "wiki-service/pkg/libs/errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// textSlot is one piece of translatable text inside a translation and the
+// way to write its translation back.
+type textSlot struct {
+	text string
+	set  func(translated string)
+}
+
+// MachineTranslate drafts the target language from sourceLanguage: the
+// source is cloned like CopyTranslation with text, then every text-bearing
+// field is sent to the configured Translator in a single batch. The result
+// is a draft marked as machine-translated until someone publishes it.
+func (u *wikiUseCase) MachineTranslate(ctx context.Context, id string, language, sourceLanguage int, req request.MachineTranslateRequest, userID string) error {
+	if id == "" {
+		return libs_errors.Required("id")
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return libs_errors.InvalidID("id")
+	}
+
+	if u.translator == nil {
+		return libs_errors.Unavailable(libs_errors.CodeTranslatorUnavailable, "machine translation is not configured")
+	}
+
+	if err := u.validateCopyLanguages(ctx, language, sourceLanguage); err != nil {
+		return err
+	}
+
+	target, err := u.languages.Enabled(ctx, "language", language)
+	if err != nil {
+		return err
+	}
+
+	source, err := u.languages.Enabled(ctx, "source_language", sourceLanguage)
+	if err != nil {
+		return err
+	}
+
+	wiki, err := u.wikiRepo.GetWikiByID(ctx, objectID)
+	if err != nil {
+		return err
+	}
+
+	if wiki == nil {
+		return libs_errors.NotFound(libs_errors.CodeWikiNotFound, "wiki not found")
+	}
+
+	before := flattenWiki(wiki)
+
+	copyReq := request.CopyTranslationRequest{IncludeText: true, Overwrite: req.Overwrite}
+	switch copyTranslation(wiki, language, sourceLanguage, copyReq) {
+	case copySkipSourceMissing:
+		return libs_errors.NotFound(libs_errors.CodeTranslationNotFound, "source translation not found")
+	case copySkipTargetExists:
+		return libs_errors.Conflict(libs_errors.CodeTranslationExists, "target translation already exists")
+	}
+
+	translation := findTranslation(wiki, language)
+	slots := collectTextSlots(translation)
+	if len(slots) > 0 {
+		texts := make([]string, len(slots))
+		for i, slot := range slots {
+			texts[i] = slot.text
+		}
+
+		translated, err := u.translator.Translate(ctx, texts, source.Code, target.Code)
+		if err != nil {
+			return libs_errors.Internal("machine translation failed", err)
+		}
+		if len(translated) != len(slots) {
+			return libs_errors.Internal("machine translation failed",
+				fmt.Errorf("got %d translations for %d texts", len(translated), len(slots)))
+		}
+
+		for i, slot := range slots {
+			slot.set(translated[i])
+		}
+	}
+
+	now := time.Now()
+	translation.MachineTranslated = &entity.MachineTranslation{
+		Provider:       u.translator.Name(),
+		SourceLanguage: sourceLanguage,
+		TranslatedAt:   now,
+		TranslatedBy:   userID,
+	}
+
+	wiki.UpdatedAt = now
+	wiki.UpdatedBy = userID
+
+	if err := u.wikiRepo.UpdateWiki(ctx, objectID, wiki); err != nil {
+		return err
+	}
+
+	entry := newWikiAuditEntry(entity.AuditActionWikiMachineTranslate, userID, wiki, &language)
+	entry.Changes = diffFields(before, flattenWiki(wiki))
+	recordAudit(ctx, u.auditRepo, entry)
+
+	return nil
+}
+
+// collectTextSlots finds the text a translator should see: title and
+// keywords, plain text element values, the titles of title, button and
+// link elements, and picture captions. Media keys, icons and URLs are left
+// alone.
+func collectTextSlots(translation *entity.Translation) []textSlot {
+	var slots []textSlot
+
+	addString := func(value **string) {
+		if *value == nil || strings.TrimSpace(**value) == "" {
+			return
+		}
+		slots = append(slots, textSlot{
+			text: **value,
+			set: func(translated string) {
+				*value = &translated
+			},
+		})
+	}
+
+	addTitle := func(title string, set func(string)) {
+		if strings.TrimSpace(title) != "" {
+			slots = append(slots, textSlot{text: title, set: set})
+		}
+	}
+
+	addString(&translation.Title)
+	addString(&translation.Keywords)
+
+	for i := range translation.Elements {
+		elem := &translation.Elements[i]
+		// Legacy values would otherwise send raw JSON to the translator
+		elem.MigratePayload()
+
+		for j := range elem.PictureKeys {
+			addString(&elem.PictureKeys[j].Title)
+		}
+
+		// Payloads are replaced rather than edited in place, since copied
+		// translations may share them
+		switch {
+		case elem.Title != nil:
+			addTitle(elem.Title.Title, func(translated string) {
+				title := *elem.Title
+				title.Title = translated
+				elem.Title = &title
+			})
+		case elem.Button != nil:
+			addTitle(elem.Button.Title, func(translated string) {
+				button := *elem.Button
+				button.Title = translated
+				elem.Button = &button
+			})
+		case elem.Link != nil:
+			addTitle(elem.Link.Title, func(translated string) {
+				link := *elem.Link
+				link.Title = translated
+				elem.Link = &link
+			})
+		case elem.Value != nil && !entity.IsMediaElementType(elem.Type):
+			addString(&elem.Value)
+		}
+	}
+
+	return slots
+}
@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/interface/http/dto/response.go"
+	libs_errors "wiki-service/pkg/libs/errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// purgeBatchSize bounds how many trashed wikis one purge run removes.
+const purgeBatchSize = 100
+
+func (u *wikiUseCase) DeleteWiki(ctx context.Context, id string, userID string) error {
+	if id == "" {
+		return libs_errors.Required("id")
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return libs_errors.InvalidID("id")
+	}
+
+	wiki, err := u.wikiRepo.GetWikiByID(ctx, objectID)
+	if err != nil {
+		return err
+	}
+
+	if wiki == nil {
+		return libs_errors.NotFound(libs_errors.CodeWikiNotFound, "wiki not found")
+	}
+
+	deleted, err := u.wikiRepo.SoftDeleteWiki(ctx, objectID, userID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return libs_errors.NotFound(libs_errors.CodeWikiNotFound, "wiki not found")
+	}
+
+	recordAudit(ctx, u.auditRepo, newWikiAuditEntry(entity.AuditActionWikiDelete, userID, wiki, nil))
+
+	return nil
+}
+
+func (u *wikiUseCase) RestoreWiki(ctx context.Context, id string, userID string) error {
+	if id == "" {
+		return libs_errors.Required("id")
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return libs_errors.InvalidID("id")
+	}
+
+	restored, err := u.wikiRepo.RestoreWiki(ctx, objectID)
+	if err != nil {
+		return err
+	}
+
+	if !restored {
+		return libs_errors.NotFound(libs_errors.CodeWikiNotInTrash, "wiki not found in trash")
+	}
+
+	entry := newWikiAuditEntry(entity.AuditActionWikiRestore, userID, nil, nil)
+	entry.WikiID = &objectID
+	recordAudit(ctx, u.auditRepo, entry)
+
+	return nil
+}
+
+func (u *wikiUseCase) GetTrash(ctx context.Context, page, limit int, typeParam string) ([]*response.WikiResponse, int64, error) {
+	if page < 1 {
+		return nil, 0, libs_errors.InvalidField("page", libs_errors.FieldMustBePositive, "page must be greater than 0", nil)
+	}
+
+	if limit < 1 {
+		return nil, 0, libs_errors.InvalidField("limit", libs_errors.FieldMustBePositive, "limit must be greater than 0", nil)
+	}
+
+	wikis, total, err := u.wikiRepo.GetTrash(ctx, page, limit, typeParam)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return u.wikisToResponses(ctx, wikis, nil), total, nil
+}
+
+// PurgeTrash permanently removes wikis that have been in the trash longer
+// than retention, then deletes the files they referenced. A wiki restored
+// after it was loaded is left alone, files included.
+func (u *wikiUseCase) PurgeTrash(ctx context.Context, retention time.Duration) error {
+	cutoff := time.Now().Add(-retention)
+
+	wikis, err := u.wikiRepo.GetTrashedBefore(ctx, cutoff, purgeBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to load trashed wikis: %w", err)
+	}
+
+	for _, wiki := range wikis {
+		deleted, err := u.wikiRepo.PurgeWiki(ctx, wiki.ID, cutoff)
+		if err != nil {
+			log.Printf("failed to purge wiki %s: %v", wiki.ID.Hex(), err)
+			continue
+		}
+		if !deleted {
+			continue
+		}
+
+		for fileKey := range collectWikiFileKeys(wiki) {
+			u.deleteFile(ctx, fileKey)
+		}
+
+		recordAudit(ctx, u.auditRepo, newWikiAuditEntry(entity.AuditActionWikiPurge, schedulerUserID, wiki, nil))
+	}
+
+	return nil
+}
+
+func (u *wikiUseCase) deleteFile(ctx context.Context, fileKey string) {
+	if strings.HasSuffix(strings.ToLower(fileKey), ".pdf") {
+		if err := u.fileGateway.DeletePDF(ctx, fileKey); err != nil {
+			log.Printf("failed to delete PDF %s: %v", fileKey, err)
+		}
+		return
+	}
+
+	if err := u.fileGateway.DeleteImage(ctx, fileKey); err != nil {
+		log.Printf("failed to delete image %s: %v", fileKey, err)
+	}
+}
+
+// collectWikiFileKeys returns every file key referenced by the wiki's working,
+// published and scheduled copies. Only element types that store file keys
+// are inspected so text values are never mistaken for files.
+func collectWikiFileKeys(wiki *entity.Wiki) map[string]bool {
+	keys := make(map[string]bool)
+	if wiki.ImageWiki != "" {
+		keys[wiki.ImageWiki] = true
+	}
+
+	for _, translation := range wiki.Translation {
+		collectElementFileKeys(translation.Elements, keys)
+		if translation.Published != nil {
+			collectElementFileKeys(translation.Published.Elements, keys)
+		}
+		if translation.Scheduled != nil {
+			collectElementFileKeys(translation.Scheduled.Elements, keys)
+		}
+	}
+
+	return keys
+}
+
+func collectElementFileKeys(elements []entity.Element, keys map[string]bool) {
+	for _, elem := range elements {
+		for _, key := range elem.FileKeys() {
+			keys[key] = true
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+	"wiki-service/internal/domain/entity"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func newTestWikiUseCase(wikis *fakeWikiRepo, files *fakeFileGateway, audit *fakeAuditRepo) *wikiUseCase {
+	return NewWikiUseCase(wikis, audit, nil, nil, nil, nil, files, nil, nil, nil).(*wikiUseCase)
+}
+
+// trashedWiki references an image and a PDF in its working copy and an
+// older image only its published copy still uses.
+func trashedWiki(code string) *entity.Wiki {
+	deletedAt := time.Now().Add(-60 * 24 * time.Hour)
+	return &entity.Wiki{
+		ID:        primitive.NewObjectID(),
+		Type:      "wiki_web",
+		Code:      code,
+		ImageWiki: code + "/cover.png",
+		DeletedAt: &deletedAt,
+		Translation: []entity.Translation{{
+			Language: intPtr(1),
+			Status:   entity.TranslationStatusDraft,
+			Elements: []entity.Element{
+				{Number: 1, Type: "document", Media: &entity.MediaPayload{Key: code + "/guide.pdf"}},
+			},
+			Published: &entity.PublishedTranslation{
+				Elements: []entity.Element{
+					{Number: 1, Type: "banner", Media: &entity.MediaPayload{Key: code + "/old.png"}},
+				},
+			},
+		}},
+	}
+}
+
+func TestPurgeTrash(t *testing.T) {
+	tests := []struct {
+		name string
+		// purge answers the conditional delete of the trashed wiki
+		purge      func(id primitive.ObjectID) (bool, error)
+		wantImages []string
+		wantPDFs   []string
+		wantAudit  []entity.AuditAction
+	}{
+		{
+			name:       "purged wikis lose every file they referenced",
+			purge:      func(id primitive.ObjectID) (bool, error) { return true, nil },
+			wantImages: []string{"W1/cover.png", "W1/old.png"},
+			wantPDFs:   []string{"W1/guide.pdf"},
+			wantAudit:  []entity.AuditAction{entity.AuditActionWikiPurge},
+		},
+		{
+			name:  "wikis restored since they were loaded keep their files",
+			purge: func(id primitive.ObjectID) (bool, error) { return false, nil },
+		},
+		{
+			name:  "a failed delete keeps the files",
+			purge: func(id primitive.ObjectID) (bool, error) { return false, errors.New("connection reset") },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wikis := newFakeWikiRepo()
+			wikis.trashed = []*entity.Wiki{trashedWiki("W1")}
+			wikis.purge = tt.purge
+			files := &fakeFileGateway{}
+			audit := &fakeAuditRepo{}
+			uc := newTestWikiUseCase(wikis, files, audit)
+
+			if err := uc.PurgeTrash(context.Background(), 30*24*time.Hour); err != nil {
+				t.Fatalf("PurgeTrash() error = %v", err)
+			}
+
+			sort.Strings(files.deletedImages)
+			if !reflect.DeepEqual(files.deletedImages, tt.wantImages) {
+				t.Errorf("deleted images = %v, want %v", files.deletedImages, tt.wantImages)
+			}
+			if !reflect.DeepEqual(files.deletedPDFs, tt.wantPDFs) {
+				t.Errorf("deleted PDFs = %v, want %v", files.deletedPDFs, tt.wantPDFs)
+			}
+			if actions := audit.actions(); !reflect.DeepEqual(actions, tt.wantAudit) {
+				t.Errorf("audit actions = %v, want %v", actions, tt.wantAudit)
+			}
+		})
+	}
+}
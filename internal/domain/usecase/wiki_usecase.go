@@ -2,10 +2,10 @@ package usecase
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"time"
 	"wiki-service/internal/domain/entity"
 	"wiki-service/internal/domain/repository"
@@ -13,6 +13,9 @@ import (
 	"wiki-service/internal/interface/http/dto/response.go"
 	"wiki-service/internal/interface/http/mapper"
 	"wiki-service/pkg/gateway"
+	libs_errors "wiki-service/pkg/libs/errors"
+	libs_helper "wiki-service/pkg/libs/helper"
+	"wiki-service/pkg/translator"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -20,41 +23,77 @@ import (
 type WikiUseCase interface {
 	CreateWikiTemplate(ctx context.Context, req request.CreateWikiTemplateRequest, userID string) error
 	GetTemplate(ctx context.Context, typeParam string) (*entity.WikiTemplate, error)
-	GetStatistics(ctx context.Context, page, limit int, typeParam, search string) ([]*response.WikiStatisticsResponse, error)
-	GetWikiByCode(ctx context.Context, code string, language *int, typeParam string) (*response.WikiResponse, error)
-	GetWikis(ctx context.Context, page, limit int, language *int, typeParam, search string) ([]*response.WikiResponse, int64, error)
-	GetWikiByID(ctx context.Context, id string, language *int) (*response.WikiResponse, error)
-	UpdateWiki(ctx context.Context, id string, req request.UpdateWikiRequest) error
+	GetStatistics(ctx context.Context, req request.GetStatisticsRequest) ([]*response.WikiStatisticsResponse, error)
+	GetTranslationProgress(ctx context.Context, req request.TranslationProgressRequest) (*response.TranslationProgressResponse, error)
+	GetTypeSummary(ctx context.Context, typeParam string) (*response.TypeSummaryResponse, error)
+	ExportStatistics(ctx context.Context, typeParam string) (*StatisticsExport, error)
+	SnapshotCompletion(ctx context.Context) error
+	GetCompletionTrends(ctx context.Context, req request.CompletionTrendsRequest) (*response.CompletionTrendsResponse, error)
+	GetWikiByCode(ctx context.Context, code string, language *int, typeParam string, draft bool) (*response.WikiResponse, error)
+	GetWikisByCodes(ctx context.Context, req request.BatchWikisRequest, draft bool) (*response.BatchWikisResponse, error)
+	GetWikis(ctx context.Context, req request.GetWikisRequest) ([]*response.WikiResponse, *response.PaginationResponse, error)
+	SuggestWikis(ctx context.Context, req request.SuggestWikisRequest) ([]*response.WikiSuggestionResponse, error)
+	GetWikiByID(ctx context.Context, id string, language *int, draft bool) (*response.WikiResponse, error)
+	UpdateWiki(ctx context.Context, id string, req request.UpdateWikiRequest, userID string) error
+	PublishTranslation(ctx context.Context, id string, language int, userID string) error
+	ScheduleWiki(ctx context.Context, id string, req request.ScheduleWikiRequest, userID string) error
+	RunDueSchedules(ctx context.Context) error
+	DeleteWiki(ctx context.Context, id string, userID string) error
+	RestoreWiki(ctx context.Context, id string, userID string) error
+	GetTrash(ctx context.Context, page, limit int, typeParam string) ([]*response.WikiResponse, int64, error)
+	PurgeTrash(ctx context.Context, retention time.Duration) error
+	GetContributors(ctx context.Context, id string) ([]*response.ContributorResponse, error)
+	CopyTranslation(ctx context.Context, id string, language, sourceLanguage int, req request.CopyTranslationRequest, userID string) error
+	CopyTranslations(ctx context.Context, typeParam string, language, sourceLanguage int, req request.CopyTranslationRequest, userID string) (*response.CopyTranslationsResponse, error)
+	MachineTranslate(ctx context.Context, id string, language, sourceLanguage int, req request.MachineTranslateRequest, userID string) error
 }
 
 type wikiUseCase struct {
 	wikiRepo     repository.WikiRepository
+	auditRepo    repository.AuditRepository
+	reviewRepo   repository.ReviewRepository
+	snapshotRepo repository.CompletionSnapshotRepository
 	fileGateway  gateway.FileGateway
 	userGateway  gateway.UserGateway
 	mediaGateway gateway.MediaGateway
+	users        *userInfoResolver
+	languages    *languageRegistry
+	translator   translator.Translator
 }
 
 func NewWikiUseCase(
 	wikiRepo repository.WikiRepository,
+	auditRepo repository.AuditRepository,
+	reviewRepo repository.ReviewRepository,
+	languageRepo repository.LanguageRepository,
+	fallbackRepo repository.LanguageFallbackRepository,
+	snapshotRepo repository.CompletionSnapshotRepository,
 	fileGateway gateway.FileGateway,
 	userGateway gateway.UserGateway,
 	mediaGateway gateway.MediaGateway,
+	textTranslator translator.Translator,
 ) WikiUseCase {
 	return &wikiUseCase{
 		wikiRepo:     wikiRepo,
+		auditRepo:    auditRepo,
+		reviewRepo:   reviewRepo,
+		snapshotRepo: snapshotRepo,
 		fileGateway:  fileGateway,
 		userGateway:  userGateway,
 		mediaGateway: mediaGateway,
+		users:        newUserInfoResolver(userGateway),
+		languages:    newLanguageRegistry(languageRepo, fallbackRepo),
+		translator:   textTranslator,
 	}
 }
 
 func (u *wikiUseCase) CreateWikiTemplate(ctx context.Context, req request.CreateWikiTemplateRequest, userID string) error {
 	if userID == "" {
-		return errors.New("userID is required")
+		return libs_errors.Required("user_id")
 	}
 
 	if len(req.Elements) == 0 {
-		return errors.New("elements is required")
+		return libs_errors.Required("elements")
 	}
 
 	if err := validateElements(req.Elements); err != nil {
@@ -109,31 +148,43 @@ func (u *wikiUseCase) CreateWikiTemplate(ctx context.Context, req request.Create
 		return err
 	}
 
+	recordAudit(ctx, u.auditRepo, &entity.AuditEntry{
+		Action:   entity.AuditActionTemplateCreate,
+		ActorID:  userID,
+		WikiType: req.Type,
+	})
+
 	return nil
 }
 
 func (u *wikiUseCase) GetTemplate(ctx context.Context, typeParam string) (*entity.WikiTemplate, error) {
 	if typeParam == "" {
-		return nil, errors.New("type is required")
+		return nil, libs_errors.Required("type")
 	}
 
 	return u.wikiRepo.GetTemplates(ctx, typeParam)
 }
 
-func (u *wikiUseCase) GetStatistics(ctx context.Context, page, limit int, typeParam, search string) ([]*response.WikiStatisticsResponse, error) {
-	if page < 1 {
-		return nil, errors.New("page must be greater than 0")
+func (u *wikiUseCase) GetStatistics(ctx context.Context, req request.GetStatisticsRequest) ([]*response.WikiStatisticsResponse, error) {
+	if req.Page < 1 {
+		return nil, libs_errors.InvalidField("page", libs_errors.FieldMustBePositive, "page must be greater than 0", nil)
 	}
 
-	if limit < 1 {
-		return nil, errors.New("limit must be greater than 0")
+	if req.Limit < 1 {
+		return nil, libs_errors.InvalidField("limit", libs_errors.FieldMustBePositive, "limit must be greater than 0", nil)
 	}
 
-	wikis, total, err := u.wikiRepo.GetWikis(ctx, page, limit, typeParam, search)
+	page, err := u.wikiRepo.GetWikis(ctx, repository.WikiFilter{Type: req.Type, Search: req.Search}, repository.WikiPageRequest{
+		Page:       req.Page,
+		Limit:      req.Limit,
+		Cursor:     req.Cursor,
+		CountTotal: req.IncludeTotal,
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	wikis := page.Wikis
 	if len(wikis) == 0 {
 		return []*response.WikiStatisticsResponse{}, nil
 	}
@@ -161,7 +212,7 @@ func (u *wikiUseCase) GetStatistics(ctx context.Context, page, limit int, typePa
 				elementKey := fmt.Sprintf("%d_%s", element.Number, element.Type)
 
 				// Check if element has value
-				hasValue := element.Value != nil && strings.TrimSpace(*element.Value) != ""
+				hasValue := element.HasContent()
 
 				if hasValue {
 					elementStats[elementKey] = "yes"
@@ -230,25 +281,37 @@ func (u *wikiUseCase) GetStatistics(ctx context.Context, page, limit int, typePa
 
 	// Add pagination info to the first response (or create a wrapper response)
 	if len(responses) > 0 {
-		totalPages := int((total + int64(limit) - 1) / int64(limit))
-		responses[0].Pagination = &response.PaginationResponse{
-			Page:       page,
-			Limit:      limit,
-			Total:      int(total),
-			TotalPages: totalPages,
-		}
+		responses[0].Pagination = paginationResponse(req.Page, req.Limit, page)
 	}
 
 	return responses, nil
 }
 
-func (u *wikiUseCase) GetWikiByCode(ctx context.Context, code string, language *int, typeParam string) (*response.WikiResponse, error) {
+// paginationResponse describes a repository page as requested.
+func paginationResponse(pageNumber, limit int, page *repository.WikiPage) *response.PaginationResponse {
+	pagination := &response.PaginationResponse{
+		Page:       pageNumber,
+		Limit:      limit,
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+	}
+
+	if page.Total != nil {
+		total := int(*page.Total)
+		totalPages := (total + limit - 1) / limit
+		pagination.Total = &total
+		pagination.TotalPages = &totalPages
+	}
+	return pagination
+}
+
+func (u *wikiUseCase) GetWikiByCode(ctx context.Context, code string, language *int, typeParam string, draft bool) (*response.WikiResponse, error) {
 	if code == "" {
-		return nil, errors.New("code is required")
+		return nil, libs_errors.Required("code")
 	}
 
 	if typeParam == "" {
-		return nil, errors.New("type is required")
+		return nil, libs_errors.Required("type")
 	}
 
 	wiki, err := u.wikiRepo.GetWikiByCode(ctx, code, typeParam)
@@ -257,7 +320,7 @@ func (u *wikiUseCase) GetWikiByCode(ctx context.Context, code string, language *
 	}
 
 	if wiki == nil {
-		return nil, errors.New("wiki not found")
+		return nil, libs_errors.NotFound(libs_errors.CodeWikiNotFound, "wiki not found")
 	}
 
 	templateWiki, err := u.wikiRepo.GetTemplates(ctx, "wiki_web")
@@ -266,91 +329,178 @@ func (u *wikiUseCase) GetWikiByCode(ctx context.Context, code string, language *
 	}
 
 	if templateWiki == nil {
-		return nil, errors.New("template wiki not found")
+		return nil, libs_errors.NotFound(libs_errors.CodeTemplateNotFound, "template wiki not found")
 	}
 
-	if language != nil {
-		filterTranslations([]*entity.Wiki{wiki}, language, templateWiki.Elements)
+	if !draft {
+		applyPublishedView([]*entity.Wiki{wiki})
 	}
 
-	// Get user info for created_by
-	var createdByUser *response.CreatedByUserInfo
-	if user, err := u.userGateway.GetCurrentUser(ctx); err == nil && user != nil {
-		createdByUser = &response.CreatedByUserInfo{
-			ID:       user.ID,
-			Username: user.Username,
-			Nickname: user.Nickname,
-			Fullname: user.Fullname,
-			Email:    user.Email,
-			Avatar:   user.AvatarURL,
+	var resolutions []*response.LanguageResolutionResponse
+	if language != nil {
+		resolutions, err = u.filterTranslations(ctx, []*entity.Wiki{wiki}, *language, templateWiki.Elements)
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	return mapper.WikiToResponse(ctx, wiki, u.fileGateway, u.mediaGateway, createdByUser), nil
+	responses := u.wikisToResponses(ctx, []*entity.Wiki{wiki}, nil)
+	attachLanguageResolutions(responses, resolutions)
+	return responses[0], nil
 
 }
 
-func (u *wikiUseCase) GetWikis(ctx context.Context, page, limit int, language *int, typeParam, search string) ([]*response.WikiResponse, int64, error) {
-	if typeParam == "" {
-		return nil, 0, errors.New("type is required")
+func (u *wikiUseCase) GetWikis(ctx context.Context, req request.GetWikisRequest) ([]*response.WikiResponse, *response.PaginationResponse, error) {
+	if req.Type == "" {
+		return nil, nil, libs_errors.Required("type")
 	}
 
-	if page < 1 {
-		return nil, 0, errors.New("page must be greater than 0")
+	if req.Page < 1 {
+		return nil, nil, libs_errors.InvalidField("page", libs_errors.FieldMustBePositive, "page must be greater than 0", nil)
 	}
 
-	if limit < 1 {
-		return nil, 0, errors.New("limit must be greater than 0")
+	if req.Limit < 1 {
+		return nil, nil, libs_errors.InvalidField("limit", libs_errors.FieldMustBePositive, "limit must be greater than 0", nil)
 	}
 
-	wikis, total, err := u.wikiRepo.GetWikis(ctx, page, limit, typeParam, search)
+	filter, err := wikiFilterFromRequest(req)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, err
 	}
 
+	fields, err := wikiFieldsFromRequest(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page, err := u.wikiRepo.GetWikis(ctx, filter, repository.WikiPageRequest{
+		Page:       req.Page,
+		Limit:      req.Limit,
+		Cursor:     req.Cursor,
+		CountTotal: req.IncludeTotal,
+		Fields:     fields,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	wikis := page.Wikis
+
 	templateWiki, err := u.wikiRepo.GetTemplates(ctx, "wiki_web")
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, err
 	}
 
 	if templateWiki == nil {
-		return nil, 0, errors.New("template wiki not found")
+		return nil, nil, libs_errors.NotFound(libs_errors.CodeTemplateNotFound, "template wiki not found")
 	}
 
-	if language != nil {
-		filterTranslations(wikis, language, templateWiki.Elements)
+	if !req.Draft {
+		applyPublishedView(wikis)
 	}
 
-	// Get current user info once for all wikis
-	var currentUser *response.CreatedByUserInfo
-	if user, err := u.userGateway.GetCurrentUser(ctx); err == nil && user != nil {
-		currentUser = &response.CreatedByUserInfo{
-			ID:       user.ID,
-			Username: user.Username,
-			Nickname: user.Nickname,
-			Fullname: user.Fullname,
-			Email:    user.Email,
-			Avatar:   user.AvatarURL,
+	var resolutions []*response.LanguageResolutionResponse
+	if req.Language != nil {
+		resolutions, err = u.filterTranslations(ctx, wikis, *req.Language, templateWiki.Elements)
+		if err != nil {
+			return nil, nil, err
 		}
 	}
 
-	// Convert wikis to responses
-	responses := make([]*response.WikiResponse, len(wikis))
-	for i, wiki := range wikis {
-		responses[i] = mapper.WikiToResponse(ctx, wiki, u.fileGateway, u.mediaGateway, currentUser)
+	responses := u.wikisToResponses(ctx, wikis, fields)
+	attachLanguageResolutions(responses, resolutions)
+	attachHighlights(responses, wikis, req.Search)
+	return responses, paginationResponse(req.Page, req.Limit, page), nil
+}
+
+// wikiFieldsFromRequest resolves the fields a listing returns. An explicit
+// field list wins over the view; "translation" selects every translation
+// field, and the full view selects everything.
+func wikiFieldsFromRequest(req request.GetWikisRequest) (entity.WikiFields, error) {
+	if len(req.Fields) == 0 {
+		switch req.View {
+		case "", wikiViewFull:
+			return nil, nil
+		case wikiViewSummary:
+			return entity.SummaryWikiFields(), nil
+		default:
+			return nil, libs_errors.InvalidField("view", libs_errors.FieldInvalidFormat, "view must be summary or full", nil)
+		}
+	}
+
+	known := make(map[string]bool, len(entity.WikiTopLevelFields)+len(entity.WikiTranslationFields))
+	for _, field := range entity.WikiTopLevelFields {
+		known[field] = true
+	}
+	for _, field := range entity.WikiTranslationFields {
+		known[field] = true
+	}
+
+	fields := entity.WikiFields{}
+	for _, field := range req.Fields {
+		field = strings.TrimSpace(field)
+		switch {
+		case field == "":
+		case field == entity.WikiFieldTranslation:
+			for _, translationField := range entity.WikiTranslationFields {
+				fields[translationField] = true
+			}
+		case known[field]:
+			fields[field] = true
+		default:
+			return nil, libs_errors.InvalidField("fields", libs_errors.FieldInvalidFormat, "unknown field "+field, map[string]interface{}{"value": field})
+		}
+	}
+	return fields, nil
+}
+
+const (
+	wikiViewSummary = "summary"
+	wikiViewFull    = "full"
+)
+
+// wikiFilterFromRequest validates the listing filters and sort. Sort takes
+// code, updated_at or title, with a leading "-" for descending order.
+func wikiFilterFromRequest(req request.GetWikisRequest) (repository.WikiFilter, error) {
+	filter := repository.WikiFilter{
+		Type:            req.Type,
+		Search:          req.Search,
+		Language:        req.Language,
+		Public:          req.Public,
+		Level:           req.Level,
+		Unit:            strings.TrimSpace(req.Unit),
+		WithLanguage:    req.WithLanguage,
+		WithoutLanguage: req.WithoutLanguage,
+		CreatedBy:       req.CreatedBy,
+		UpdatedFrom:     req.UpdatedFrom,
+		UpdatedTo:       req.UpdatedTo,
+		Draft:           req.Draft,
+	}
+
+	if req.UpdatedFrom != nil && req.UpdatedTo != nil && req.UpdatedTo.Before(*req.UpdatedFrom) {
+		return filter, libs_errors.InvalidField("updated_to", libs_errors.FieldMustBeAfter, "updated_to must be after updated_from", map[string]interface{}{"other": "updated_from"})
 	}
 
-	return responses, total, nil
+	if req.Sort != "" {
+		field := strings.TrimPrefix(req.Sort, "-")
+		switch field {
+		case repository.WikiSortCode, repository.WikiSortUpdatedAt, repository.WikiSortTitle:
+		default:
+			return filter, libs_errors.InvalidField("sort", libs_errors.FieldInvalidFormat, "sort must be code, updated_at or title", nil)
+		}
+		filter.Sort = repository.WikiSort{Field: field, Descending: strings.HasPrefix(req.Sort, "-")}
+	}
+
+	return filter, nil
 }
 
-func (u *wikiUseCase) GetWikiByID(ctx context.Context, id string, language *int) (*response.WikiResponse, error) {
+func (u *wikiUseCase) GetWikiByID(ctx context.Context, id string, language *int, draft bool) (*response.WikiResponse, error) {
 	if id == "" {
-		return nil, errors.New("id is required")
+		return nil, libs_errors.Required("id")
 	}
 
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, errors.New("invalid id format")
+		return nil, libs_errors.InvalidID("id")
 	}
 
 	wiki, err := u.wikiRepo.GetWikiByID(ctx, objectID)
@@ -359,7 +509,7 @@ func (u *wikiUseCase) GetWikiByID(ctx context.Context, id string, language *int)
 	}
 
 	if wiki == nil {
-		return nil, errors.New("wiki not found")
+		return nil, libs_errors.NotFound(libs_errors.CodeWikiNotFound, "wiki not found")
 	}
 
 	templateWiki, err := u.wikiRepo.GetTemplates(ctx, "wiki_web")
@@ -368,50 +518,47 @@ func (u *wikiUseCase) GetWikiByID(ctx context.Context, id string, language *int)
 	}
 
 	if templateWiki == nil {
-		return nil, errors.New("template wiki not found")
+		return nil, libs_errors.NotFound(libs_errors.CodeTemplateNotFound, "template wiki not found")
 	}
 
-	if language != nil {
-		filterTranslations([]*entity.Wiki{wiki}, language, templateWiki.Elements)
+	if !draft {
+		applyPublishedView([]*entity.Wiki{wiki})
 	}
 
-	// Get user info for created_by
-	var createdByUser *response.CreatedByUserInfo
-	if user, err := u.userGateway.GetCurrentUser(ctx); err == nil && user != nil {
-		createdByUser = &response.CreatedByUserInfo{
-			ID:       user.ID,
-			Username: user.Username,
-			Nickname: user.Nickname,
-			Fullname: user.Fullname,
-			Email:    user.Email,
-			Avatar:   user.AvatarURL,
+	var resolutions []*response.LanguageResolutionResponse
+	if language != nil {
+		resolutions, err = u.filterTranslations(ctx, []*entity.Wiki{wiki}, *language, templateWiki.Elements)
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	wikiRes := mapper.WikiToResponse(ctx, wiki, u.fileGateway, u.mediaGateway, createdByUser)
-
-	return wikiRes, nil
+	responses := u.wikisToResponses(ctx, []*entity.Wiki{wiki}, nil)
+	attachLanguageResolutions(responses, resolutions)
+	return responses[0], nil
 }
 
-func (u *wikiUseCase) UpdateWiki(ctx context.Context, id string, req request.UpdateWikiRequest) error {
+func (u *wikiUseCase) UpdateWiki(ctx context.Context, id string, req request.UpdateWikiRequest, userID string) error {
 	if id == "" {
-		return errors.New("id is required")
+		return libs_errors.Required("id")
 	}
 
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return errors.New("invalid id format")
+		return libs_errors.InvalidID("id")
 	}
 
 	wiki, err := u.wikiRepo.GetWikiByID(ctx, objectID)
 	if err != nil {
-		return errors.New("wiki not found")
+		return err
 	}
 
 	if wiki == nil {
-		return errors.New("wiki not found")
+		return libs_errors.NotFound(libs_errors.CodeWikiNotFound, "wiki not found")
 	}
 
+	before := flattenWiki(wiki)
+
 	if req.ImageWiki != nil {
 		wiki.ImageWiki = *req.ImageWiki
 	}
@@ -420,26 +567,31 @@ func (u *wikiUseCase) UpdateWiki(ctx context.Context, id string, req request.Upd
 		wiki.Public = *req.Public
 	}
 
-	if req.Language != nil && *req.Language < 0 {
-		return errors.New("language must be greater than or equal to 0")
+	if req.Language == nil {
+		// Without a language only wiki-level fields can change; translation
+		// content always belongs to a registered language.
+		if req.Title != nil || req.Keywords != nil || req.Level != nil || req.Unit != nil || len(req.Elements) > 0 {
+			return libs_errors.Required("language")
+		}
+		return u.saveWikiUpdate(ctx, objectID, wiki, before, userID, nil)
 	}
 
-	var translation *entity.Translation
-	for i := range wiki.Translation {
-		if wiki.Translation[i].Language != nil && req.Language != nil &&
-			*wiki.Translation[i].Language == *req.Language {
-			translation = &wiki.Translation[i]
-			break
-		}
-		if req.Language != nil && wiki.Translation[i].Language == nil {
-			translation = &wiki.Translation[i]
-			break
-		}
+	if _, err := u.languages.Enabled(ctx, "language", *req.Language); err != nil {
+		return err
+	}
+
+	// Prefer the translation already in this language; otherwise adopt the
+	// untranslated seed created with the template, if it is still unclaimed.
+	translation := findTranslation(wiki, *req.Language)
+	if translation != nil {
+		freezeLegacyContent(wiki, translation)
+	} else {
+		translation = untranslatedSeed(wiki)
 	}
 
 	if translation == nil {
 		if len(req.Elements) == 0 {
-			return errors.New("elements are required when creating a new translation")
+			return libs_errors.InvalidField("elements", libs_errors.FieldRequired, "elements are required when creating a new translation", nil)
 		}
 		if err := validateElements(req.Elements); err != nil {
 			return err
@@ -450,9 +602,7 @@ func (u *wikiUseCase) UpdateWiki(ctx context.Context, id string, req request.Upd
 		translation = &wiki.Translation[len(wiki.Translation)-1]
 	}
 
-	if req.Language != nil {
-		translation.Language = req.Language
-	}
+	translation.Language = req.Language
 
 	if req.Title != nil {
 		translation.Title = req.Title
@@ -475,195 +625,478 @@ func (u *wikiUseCase) UpdateWiki(ctx context.Context, id string, req request.Upd
 			return err
 		}
 
-		if err := u.mergeElements(ctx, translation, req.Elements); err != nil {
+		if err := u.mergeElements(ctx, wiki, translation, req.Elements); err != nil {
 			return err
 		}
 	}
 
+	// Edits only touch the working copy; readers keep seeing the published
+	// copy until PublishTranslation promotes the draft.
+	translation.Status = entity.TranslationStatusDraft
+
+	return u.saveWikiUpdate(ctx, objectID, wiki, before, userID, req.Language)
+}
+
+// saveWikiUpdate stamps and stores an edited wiki and audits the fields that
+// changed since before was captured.
+func (u *wikiUseCase) saveWikiUpdate(ctx context.Context, objectID primitive.ObjectID, wiki *entity.Wiki, before map[string]interface{}, userID string, language *int) error {
 	wiki.UpdatedAt = time.Now()
+	wiki.UpdatedBy = userID
+
+	if err := u.wikiRepo.UpdateWiki(ctx, objectID, wiki); err != nil {
+		return err
+	}
+
+	entry := newWikiAuditEntry(entity.AuditActionWikiUpdate, userID, wiki, language)
+	entry.Changes = diffFields(before, flattenWiki(wiki))
+	recordAudit(ctx, u.auditRepo, entry)
+
+	return nil
+}
+
+func (u *wikiUseCase) PublishTranslation(ctx context.Context, id string, language int, userID string) error {
+	if id == "" {
+		return libs_errors.Required("id")
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return libs_errors.InvalidID("id")
+	}
+
+	wiki, err := u.wikiRepo.GetWikiByID(ctx, objectID)
+	if err != nil {
+		return err
+	}
+
+	if wiki == nil {
+		return libs_errors.NotFound(libs_errors.CodeWikiNotFound, "wiki not found")
+	}
+
+	translation := findTranslation(wiki, language)
+	if translation == nil {
+		return libs_errors.NotFound(libs_errors.CodeTranslationNotFound, "translation not found")
+	}
+
+	before := flattenWiki(wiki)
+	now := time.Now()
+	publishTranslation(translation, userID, now)
+	wiki.UpdatedAt = now
+	wiki.UpdatedBy = userID
+
+	if err := u.wikiRepo.UpdateWiki(ctx, objectID, wiki); err != nil {
+		return err
+	}
+
+	entry := newWikiAuditEntry(entity.AuditActionWikiPublish, userID, wiki, &language)
+	entry.Changes = diffFields(before, flattenWiki(wiki))
+	recordAudit(ctx, u.auditRepo, entry)
+
+	return nil
+}
+
+// contributionActions are the audit actions that count as editing a wiki.
+var contributionActions = []entity.AuditAction{
+	entity.AuditActionWikiUpdate,
+	entity.AuditActionWikiPublish,
+	entity.AuditActionWikiSchedule,
+	entity.AuditActionWikiCopy,
+	entity.AuditActionWikiMachineTranslate,
+}
+
+func (u *wikiUseCase) GetContributors(ctx context.Context, id string) ([]*response.ContributorResponse, error) {
+	if id == "" {
+		return nil, libs_errors.Required("id")
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, libs_errors.InvalidID("id")
+	}
+
+	wiki, err := u.wikiRepo.GetWikiByID(ctx, objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if wiki == nil {
+		return nil, libs_errors.NotFound(libs_errors.CodeWikiNotFound, "wiki not found")
+	}
+
+	contributors, err := u.auditRepo.GetContributors(ctx, objectID, contributionActions)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]string, 0, len(contributors))
+	for _, contributor := range contributors {
+		userIDs = append(userIDs, contributor.UserID)
+	}
+	users := u.users.Resolve(ctx, userIDs)
+
+	responses := make([]*response.ContributorResponse, 0, len(contributors))
+	for _, contributor := range contributors {
+		responses = append(responses, &response.ContributorResponse{
+			UserID:      contributor.UserID,
+			User:        users[contributor.UserID],
+			EditCount:   contributor.EditCount,
+			FirstEditAt: contributor.FirstEditAt,
+			LastEditAt:  contributor.LastEditAt,
+		})
+	}
 
-	return u.wikiRepo.UpdateWiki(ctx, objectID, wiki)
+	return responses, nil
+}
+
+// wikiMapWorkers bounds how many wikis are mapped, and so how many URL
+// lookups run, at once.
+const wikiMapWorkers = 8
+
+// wikisToResponses maps wikis to responses, resolving creators and last
+// editors for the whole batch in one lookup. fields limits the responses
+// to the selected fields; users are only resolved when selected. Wikis are
+// mapped concurrently and share their URL lookups, so a key used by
+// several wikis is resolved once.
+func (u *wikiUseCase) wikisToResponses(ctx context.Context, wikis []*entity.Wiki, fields entity.WikiFields) []*response.WikiResponse {
+	userIDs := make([]string, 0, len(wikis)*2)
+	for _, wiki := range wikis {
+		if wiki == nil {
+			continue
+		}
+		if fields.Has(entity.WikiFieldCreatedBy) {
+			userIDs = append(userIDs, wiki.CreatedBy)
+		}
+		if fields.Has(entity.WikiFieldUpdatedBy) {
+			userIDs = append(userIDs, wiki.UpdatedBy)
+		}
+	}
+	users := map[string]*response.CreatedByUserInfo{}
+	if len(userIDs) > 0 {
+		users = u.users.Resolve(ctx, userIDs)
+	}
+
+	fileGateway, mediaGateway := u.sharedGateways()
+	responses := make([]*response.WikiResponse, len(wikis))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < wikiMapWorkers && w < len(wikis); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				wiki := wikis[i]
+				responses[i] = mapper.WikiToResponse(ctx, wiki, fields, fileGateway, mediaGateway, users[wiki.CreatedBy], users[wiki.UpdatedBy])
+			}
+		}()
+	}
+	for i, wiki := range wikis {
+		if wiki != nil {
+			jobs <- i
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return responses
 }
 
 func convertElements(reqElements []request.Element, includeValues bool) []entity.Element {
 	elements := make([]entity.Element, len(reqElements))
 	for i, elem := range reqElements {
-		var value *string
-		var pictureKeys []entity.PictureItem
+		elements[i] = entity.Element{
+			Number:  elem.Number,
+			Type:    elem.Type,
+			VideoID: elem.VideoID,
+		}
 
 		if includeValues {
+			elements[i].SetValue(elem.Value)
 			if elem.Type == "picture" {
-				// For picture type, use the first picture key as value for backward compatibility
-				// and store all PictureItems in PictureKeys
-				if len(elem.PictureKeys) > 0 {
-					value = &elem.PictureKeys[0].Key // First key as main value
-					// Convert request.PictureItem to entity.PictureItem
-					pictureKeys = make([]entity.PictureItem, len(elem.PictureKeys))
-					for j, reqItem := range elem.PictureKeys {
-						pictureKeys[j] = entity.PictureItem{
-							Key:   reqItem.Key,
-							Order: reqItem.Order,
-							Title: reqItem.Title,
-						}
-					}
-				}
-			} else {
-				value = elem.Value
+				elements[i].PictureKeys = pictureItems(elem.PictureKeys)
 			}
 		}
-
-		elements[i] = entity.Element{
-			Number:      elem.Number,
-			Type:        elem.Type,
-			Value:       value,
-			PictureKeys: pictureKeys,
-			VideoID:     elem.VideoID,
-		}
 	}
 
 	return elements
 }
 
+func pictureItems(reqItems []request.PictureItem) []entity.PictureItem {
+	if len(reqItems) == 0 {
+		return nil
+	}
+
+	items := make([]entity.PictureItem, len(reqItems))
+	for i, reqItem := range reqItems {
+		items[i] = entity.PictureItem{
+			Key:   reqItem.Key,
+			Order: reqItem.Order,
+			Title: reqItem.Title,
+		}
+	}
+	return items
+}
+
 func cloneElements(elements []entity.Element) []entity.Element {
 	cloned := make([]entity.Element, len(elements))
 	copy(cloned, elements)
 	return cloned
 }
 
-func filterTranslations(wikis []*entity.Wiki, language *int, templateElements []entity.Element) {
-	for _, wiki := range wikis {
+// filterTranslations narrows every wiki to a single translation for the
+// requested language. When the wiki has none, the configured fallback chain
+// is walked; if that also fails the template elements are served in the
+// requested language. The returned resolutions are aligned with wikis.
+func (u *wikiUseCase) filterTranslations(ctx context.Context, wikis []*entity.Wiki, language int, templateElements []entity.Element) ([]*response.LanguageResolutionResponse, error) {
+	if _, err := u.languages.Enabled(ctx, "language", language); err != nil {
+		return nil, err
+	}
+
+	organizationID := libs_helper.GetOrganizationID(ctx)
+	resolutions := make([]*response.LanguageResolutionResponse, len(wikis))
+
+	for i, wiki := range wikis {
 		if wiki == nil {
 			continue
 		}
 
-		filtered := make([]entity.Translation, 0, 1)
-		for _, translation := range wiki.Translation {
-			if translation.Language == nil {
-				continue
-			}
-			if *translation.Language == *language {
-				filtered = append(filtered, translation)
+		chain, firstAvailable, err := u.languages.Chain(ctx, organizationID, wiki.Type, language)
+		if err != nil {
+			return nil, err
+		}
+
+		resolution := &response.LanguageResolutionResponse{Requested: language}
+		resolutions[i] = resolution
+
+		var served *entity.Translation
+		for _, candidate := range chain {
+			if served = findTranslation(wiki, candidate); served != nil {
 				break
 			}
 		}
+		if served == nil && firstAvailable {
+			for j := range wiki.Translation {
+				candidate := wiki.Translation[j].Language
+				if candidate != nil && u.languages.IsEnabled(ctx, *candidate) {
+					served = &wiki.Translation[j]
+					break
+				}
+			}
+		}
 
-		if len(filtered) == 0 {
-			filtered = append(filtered, entity.Translation{
-				Language: language,
+		if served == nil {
+			lang := language
+			wiki.Translation = []entity.Translation{{
+				Language: &lang,
 				Title:    nil,
 				Keywords: nil,
 				Level:    nil,
 				Unit:     nil,
 				Elements: templateElements,
-			})
+			}}
+			continue
+		}
+
+		servedLanguage := *served.Language
+		resolution.Served = &servedLanguage
+		resolution.FallbackUsed = servedLanguage != language
+		wiki.Translation = []entity.Translation{*served}
+	}
+	return resolutions, nil
+}
+
+// attachLanguageResolutions copies the outcome of filterTranslations onto
+// the matching responses.
+func attachLanguageResolutions(responses []*response.WikiResponse, resolutions []*response.LanguageResolutionResponse) {
+	for i, resp := range responses {
+		if resp != nil && i < len(resolutions) {
+			resp.LanguageResolution = resolutions[i]
+		}
+	}
+}
+
+// untranslatedSeed returns the language-less translation created with the
+// template, which the first real language claims instead of adding another.
+func untranslatedSeed(wiki *entity.Wiki) *entity.Translation {
+	for i := range wiki.Translation {
+		if wiki.Translation[i].Language == nil {
+			return &wiki.Translation[i]
+		}
+	}
+	return nil
+}
+
+func findTranslation(wiki *entity.Wiki, language int) *entity.Translation {
+	for i := range wiki.Translation {
+		if wiki.Translation[i].Language != nil && *wiki.Translation[i].Language == language {
+			return &wiki.Translation[i]
+		}
+	}
+	return nil
+}
+
+// publishTranslation freezes the current working copy as the published copy.
+func publishTranslation(translation *entity.Translation, userID string, now time.Time) {
+	translation.Published = snapshotTranslation(translation, userID, now)
+	translation.Status = entity.TranslationStatusPublished
+	translation.MachineTranslated = nil
+}
+
+// snapshotTranslation copies the working copy of a translation, sharing no
+// elements with it.
+func snapshotTranslation(translation *entity.Translation, userID string, now time.Time) *entity.PublishedTranslation {
+	return &entity.PublishedTranslation{
+		Title:       translation.Title,
+		Keywords:    translation.Keywords,
+		Level:       translation.Level,
+		Unit:        translation.Unit,
+		Elements:    cloneElements(translation.Elements),
+		PublishedAt: now,
+		PublishedBy: userID,
+	}
+}
+
+// freezeLegacyContent keeps a legacy translation live when it first enters
+// the draft workflow: the content readers have been seeing becomes its
+// published copy, so the edit that follows stays a draft.
+func freezeLegacyContent(wiki *entity.Wiki, translation *entity.Translation) {
+	if translation.Status != "" || translation.Published != nil {
+		return
+	}
+	publishTranslation(translation, wiki.UpdatedBy, wiki.UpdatedAt)
+}
+
+// applyPublishedView replaces each translation's working copy with its
+// published copy. Translations that have a draft but were never published
+// are dropped so readers cannot see unreviewed content.
+func applyPublishedView(wikis []*entity.Wiki) {
+	for _, wiki := range wikis {
+		if wiki == nil {
+			continue
+		}
+
+		visible := make([]entity.Translation, 0, len(wiki.Translation))
+		for _, translation := range wiki.Translation {
+			if translation.Published != nil {
+				published := translation.Published
+				translation.Title = published.Title
+				translation.Keywords = published.Keywords
+				translation.Level = published.Level
+				translation.Unit = published.Unit
+				translation.Elements = published.Elements
+				translation.MachineTranslated = nil
+				visible = append(visible, translation)
+				continue
+			}
+
+			// Legacy documents predate the workflow and are served as stored
+			if translation.Status == "" {
+				visible = append(visible, translation)
+			}
 		}
 
-		wiki.Translation = filtered
+		wiki.Translation = visible
 	}
 }
 
+// validateElements checks every element and reports all problems at once,
+// keyed by the element's position in the request.
 func validateElements(elements []request.Element) error {
 	numberSet := make(map[int]bool)
+	var fields []libs_errors.FieldError
 
-	for _, element := range elements {
+	for i, element := range elements {
+		prefix := fmt.Sprintf("elements[%d].", i)
 		if element.Number <= 0 {
-			return fmt.Errorf("element number must be positive, got: %d", element.Number)
+			fields = append(fields, libs_errors.FieldError{
+				Field:   prefix + "number",
+				Code:    libs_errors.FieldMustBePositive,
+				Message: fmt.Sprintf("element number must be positive, got: %d", element.Number),
+				Params:  map[string]interface{}{"value": element.Number},
+			})
 		}
 		if element.Type == "" {
-			return errors.New("type is required")
+			fields = append(fields, libs_errors.FieldError{
+				Field:   prefix + "type",
+				Code:    libs_errors.FieldRequired,
+				Message: "type is required",
+			})
 		}
 		if element.Status == "" {
-			return errors.New("status is required")
+			fields = append(fields, libs_errors.FieldError{
+				Field:   prefix + "status",
+				Code:    libs_errors.FieldRequired,
+				Message: "status is required",
+			})
 		}
-		if numberSet[element.Number] {
-			return fmt.Errorf("duplicate element number: %d", element.Number)
+		if element.Number > 0 && numberSet[element.Number] {
+			fields = append(fields, libs_errors.FieldError{
+				Field:   prefix + "number",
+				Code:    libs_errors.FieldDuplicate,
+				Message: fmt.Sprintf("duplicate element number: %d", element.Number),
+				Params:  map[string]interface{}{"value": element.Number},
+			})
 		}
 		numberSet[element.Number] = true
 	}
-	return nil
-}
 
-func (u *wikiUseCase) mergeElements(ctx context.Context, translation *entity.Translation, reqElements []request.Element) error {
-	// PHASE 1: Collect all file keys being used in the request
-	// This ensures we never delete files that are still in use (even if repositioned)
-	requestFileKeys := make(map[string]bool)
-	for _, reqElem := range reqElements {
-		// Collect single file keys (banner, graphic, etc.)
-		if reqElem.Value != nil && *reqElem.Value != "" {
-			requestFileKeys[*reqElem.Value] = true
-		}
-		// Collect picture keys
-		for _, picItem := range reqElem.PictureKeys {
-			if picItem.Key != "" {
-				requestFileKeys[picItem.Key] = true
-			}
-		}
+	if len(fields) > 0 {
+		return libs_errors.Validation(fields[0].Message, fields...)
 	}
+	return nil
+}
 
-	// PHASE 2: Collect all existing file keys (for cleanup later)
+func (u *wikiUseCase) mergeElements(ctx context.Context, wiki *entity.Wiki, translation *entity.Translation, reqElements []request.Element) error {
+	// PHASE 1: Collect all existing file keys (for cleanup later)
 	existingFileKeys := make(map[string]bool)
 	for _, elem := range translation.Elements {
-		if elem.Value != nil && *elem.Value != "" {
-			existingFileKeys[*elem.Value] = true
-		}
-		for _, picItem := range elem.PictureKeys {
-			if picItem.Key != "" {
-				existingFileKeys[picItem.Key] = true
-			}
+		for _, key := range elem.FileKeys() {
+			existingFileKeys[key] = true
 		}
 	}
 
-	// PHASE 3: Build new elements array from request (completely replace old array)
-	// This ensures no elements are lost during position changes
+	// PHASE 2: Build new elements array from request (completely replace old array)
+	// This ensures no elements are lost during position changes. Values are
+	// stored in the typed form their element type uses.
 	newElements := make([]entity.Element, len(reqElements))
 	for i, reqElem := range reqElements {
 		newElem := entity.Element{
-			Number: reqElem.Number,
-			Type:   reqElem.Type,
-			Status: reqElem.Status,
+			Number:  reqElem.Number,
+			Type:    reqElem.Type,
+			Status:  reqElem.Status,
+			VideoID: reqElem.VideoID,
 		}
+		newElem.SetValue(reqElem.Value)
 
 		// Handle picture type
 		if strings.EqualFold(reqElem.Type, "picture") {
-			if len(reqElem.PictureKeys) > 0 {
-				// Convert request.PictureItem to entity.PictureItem
-				newElem.PictureKeys = make([]entity.PictureItem, len(reqElem.PictureKeys))
-				for j, reqItem := range reqElem.PictureKeys {
-					newElem.PictureKeys[j] = entity.PictureItem{
-						Key:   reqItem.Key,
-						Order: reqItem.Order,
-						Title: reqItem.Title,
-					}
-				}
-				// Set first key as main value for backward compatibility
-				if len(reqElem.PictureKeys) > 0 {
-					newElem.Value = &reqElem.PictureKeys[0].Key
-				}
-			}
-		} else {
-			// Handle other types (text, banner, graphic, etc.)
-			if reqElem.Value != nil {
-				newElem.Value = reqElem.Value
-			}
-		}
-
-		// Set video ID if present
-		if reqElem.VideoID != nil {
-			newElem.VideoID = reqElem.VideoID
+			newElem.PictureKeys = pictureItems(reqElem.PictureKeys)
 		}
 
 		newElements[i] = newElem
 	}
 
+	// PHASE 3: Collect all file keys being used in the request
+	// This ensures we never delete files that are still in use (even if repositioned)
+	requestFileKeys := make(map[string]bool)
+	for _, elem := range newElements {
+		for _, key := range elem.FileKeys() {
+			requestFileKeys[key] = true
+		}
+	}
+
 	// PHASE 4: Replace old elements array with new one
 	// This is atomic operation - no partial updates
 	translation.Elements = newElements
 
 	// PHASE 5: Cleanup unused files
-	// Delete files that were in old elements but not in new request
+	// Delete files that were in old elements but not in new request.
+	// Published copies keep the media of the previous working copy, and
+	// translations copied from each other share media keys, so keys still
+	// referenced anywhere else in the wiki are kept.
+	wikiFileKeys := collectWikiFileKeys(wiki)
 	for fileKey := range existingFileKeys {
 		// Skip if file is still being used in request
-		if requestFileKeys[fileKey] {
+		if requestFileKeys[fileKey] || wikiFileKeys[fileKey] {
 			continue
 		}
 
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+	"wiki-service/internal/domain/entity"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestApplyPublishedView(t *testing.T) {
+	tests := []struct {
+		name        string
+		translation entity.Translation
+		wantVisible bool
+		wantTitle   string
+	}{
+		{
+			name: "published translations show the published copy",
+			translation: entity.Translation{
+				Language:  intPtr(1),
+				Title:     stringPtr("Draft"),
+				Status:    entity.TranslationStatusDraft,
+				Published: &entity.PublishedTranslation{Title: stringPtr("Live")},
+			},
+			wantVisible: true,
+			wantTitle:   "Live",
+		},
+		{
+			name: "never published drafts are hidden",
+			translation: entity.Translation{
+				Language: intPtr(1),
+				Title:    stringPtr("Draft"),
+				Status:   entity.TranslationStatusDraft,
+			},
+		},
+		{
+			name: "legacy translations are shown as stored",
+			translation: entity.Translation{
+				Language: intPtr(1),
+				Title:    stringPtr("Legacy"),
+			},
+			wantVisible: true,
+			wantTitle:   "Legacy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wiki := &entity.Wiki{Translation: []entity.Translation{tt.translation}}
+			applyPublishedView([]*entity.Wiki{wiki})
+
+			if visible := len(wiki.Translation) == 1; visible != tt.wantVisible {
+				t.Fatalf("visible = %v, want %v", visible, tt.wantVisible)
+			}
+			if tt.wantVisible && *wiki.Translation[0].Title != tt.wantTitle {
+				t.Errorf("title = %q, want %q", *wiki.Translation[0].Title, tt.wantTitle)
+			}
+		})
+	}
+}
+
+func TestFreezeLegacyContent(t *testing.T) {
+	updatedAt := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		translation   entity.Translation
+		wantPublished string
+	}{
+		{
+			name: "legacy content becomes the published copy",
+			translation: entity.Translation{
+				Language: intPtr(1),
+				Title:    stringPtr("Legacy"),
+			},
+			wantPublished: "Legacy",
+		},
+		{
+			name: "an existing published copy is kept",
+			translation: entity.Translation{
+				Language:  intPtr(1),
+				Title:     stringPtr("Draft"),
+				Status:    entity.TranslationStatusDraft,
+				Published: &entity.PublishedTranslation{Title: stringPtr("Live")},
+			},
+			wantPublished: "Live",
+		},
+		{
+			name: "never published drafts stay unpublished",
+			translation: entity.Translation{
+				Language: intPtr(1),
+				Title:    stringPtr("Draft"),
+				Status:   entity.TranslationStatusDraft,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wiki := &entity.Wiki{
+				ID:          primitive.NewObjectID(),
+				UpdatedAt:   updatedAt,
+				UpdatedBy:   "legacy-editor",
+				Translation: []entity.Translation{tt.translation},
+			}
+			translation := &wiki.Translation[0]
+			freezeLegacyContent(wiki, translation)
+
+			// What readers see must not change by entering the workflow
+			translation.Title = stringPtr("Edited")
+			translation.Status = entity.TranslationStatusDraft
+			applyPublishedView([]*entity.Wiki{wiki})
+
+			if tt.wantPublished == "" {
+				if len(wiki.Translation) != 0 {
+					t.Errorf("draft became visible with title %q", *wiki.Translation[0].Title)
+				}
+				return
+			}
+			if len(wiki.Translation) != 1 {
+				t.Fatalf("translation was hidden from readers")
+			}
+			if title := *wiki.Translation[0].Title; title != tt.wantPublished {
+				t.Errorf("reader title = %q, want %q", title, tt.wantPublished)
+			}
+		})
+	}
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
+
+func intPtr(value int) *int {
+	return &value
+}
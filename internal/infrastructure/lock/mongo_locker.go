@@ -0,0 +1,51 @@
+package lock
+
+import (
+	"context"
+	"time"
+
+	"wiki-service/pkg/scheduler"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoLocker struct {
+	collection *mongo.Collection
+}
+
+func NewMongoLocker(db *mongo.Database) scheduler.Locker {
+	return &mongoLocker{
+		collection: db.Collection("scheduler_locks"),
+	}
+}
+
+// TryLock takes the lease when it is free, expired or already ours. When
+// another owner holds a live lease the filter misses, the upsert collides on
+// _id and the lock is reported as not acquired.
+func (l *mongoLocker) TryLock(ctx context.Context, name, owner string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	filter := bson.M{
+		"_id": name,
+		"$or": bson.A{
+			bson.M{"expires_at": bson.M{"$lte": now}},
+			bson.M{"owner": owner},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"owner":      owner,
+			"expires_at": now.Add(ttl),
+		},
+	}
+
+	_, err := l.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/domain/repository"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type auditRepositoryMongo struct {
+	collection *mongo.Collection
+}
+
+func NewAuditRepositoryMongo(db *mongo.Database) repository.AuditRepository {
+	return &auditRepositoryMongo{
+		collection: db.Collection("audit_logs"),
+	}
+}
+
+func (r *auditRepositoryMongo) Create(ctx context.Context, entry *entity.AuditEntry) error {
+	_, err := r.collection.InsertOne(ctx, entry)
+	return mapMongoError(err)
+}
+
+func (r *auditRepositoryMongo) Find(ctx context.Context, filter repository.AuditFilter, page, limit int) ([]*entity.AuditEntry, int64, error) {
+	query := bson.M{}
+	if filter.WikiID != nil {
+		query["wiki_id"] = *filter.WikiID
+	}
+	if filter.WikiType != "" {
+		query["wiki_type"] = filter.WikiType
+	}
+	if filter.WikiCode != "" {
+		query["wiki_code"] = filter.WikiCode
+	}
+	if filter.ActorID != "" {
+		query["actor_id"] = filter.ActorID
+	}
+	if filter.Action != "" {
+		query["action"] = filter.Action
+	}
+	if filter.Language != nil {
+		query["language"] = *filter.Language
+	}
+	if filter.From != nil || filter.To != nil {
+		createdAt := bson.M{}
+		if filter.From != nil {
+			createdAt["$gte"] = *filter.From
+		}
+		if filter.To != nil {
+			createdAt["$lte"] = *filter.To
+		}
+		query["created_at"] = createdAt
+	}
+
+	total, err := r.collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, mapMongoError(err)
+	}
+
+	findOptions := options.Find().
+		SetSkip(int64((page - 1) * limit)).
+		SetLimit(int64(limit)).
+		SetSort(bson.M{"created_at": -1})
+
+	cursor, err := r.collection.Find(ctx, query, findOptions)
+	if err != nil {
+		return nil, 0, mapMongoError(err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	entries := make([]*entity.AuditEntry, 0, limit)
+	for cursor.Next(ctx) {
+		var entry entity.AuditEntry
+		if err := cursor.Decode(&entry); err != nil {
+			return nil, 0, mapMongoError(err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, 0, mapMongoError(err)
+	}
+
+	return entries, total, nil
+}
+
+func (r *auditRepositoryMongo) GetContributors(ctx context.Context, wikiID primitive.ObjectID, actions []entity.AuditAction) ([]*entity.Contributor, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"wiki_id":  wikiID,
+			"action":   bson.M{"$in": actions},
+			"actor_id": bson.M{"$ne": ""},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":           "$actor_id",
+			"edit_count":    bson.M{"$sum": 1},
+			"first_edit_at": bson.M{"$min": "$created_at"},
+			"last_edit_at":  bson.M{"$max": "$created_at"},
+		}}},
+		{{Key: "$sort", Value: bson.M{"last_edit_at": -1}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, mapMongoError(err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var contributors []*entity.Contributor
+	if err := cursor.All(ctx, &contributors); err != nil {
+		return nil, mapMongoError(err)
+	}
+
+	return contributors, nil
+}
+
+// EnsureIndexes backs the wiki history, actor and contributor queries, which
+// filter on a wiki or an actor and sort by time.
+func (r *auditRepositoryMongo) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "wiki_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("audit_logs_wiki_created_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "actor_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("audit_logs_actor_created_at"),
+		},
+	})
+	return mapMongoError(err)
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/domain/repository"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type completionSnapshotRepositoryMongo struct {
+	collection *mongo.Collection
+}
+
+func NewCompletionSnapshotRepositoryMongo(db *mongo.Database) repository.CompletionSnapshotRepository {
+	return &completionSnapshotRepositoryMongo{
+		collection: db.Collection("completion_snapshots"),
+	}
+}
+
+// Upsert stores the snapshot for its type, language and day, replacing one
+// taken earlier the same day.
+func (r *completionSnapshotRepositoryMongo) Upsert(ctx context.Context, snapshot *entity.CompletionSnapshot) error {
+	filter := bson.M{
+		"type":     snapshot.Type,
+		"language": snapshot.Language,
+		"date":     snapshot.Date,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"total_wikis":     snapshot.TotalWikis,
+			"wikis_started":   snapshot.WikisStarted,
+			"wikis_complete":  snapshot.WikisComplete,
+			"elements_filled": snapshot.ElementsFilled,
+			"elements_total":  snapshot.ElementsTotal,
+			"taken_at":        snapshot.TakenAt,
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return mapMongoError(err)
+}
+
+// GetRange returns the snapshots of a type taken between from and to
+// inclusive, ordered by language then date. A nil language returns every
+// language.
+func (r *completionSnapshotRepositoryMongo) GetRange(ctx context.Context, typeParam string, language *int, from, to time.Time) ([]*entity.CompletionSnapshot, error) {
+	filter := bson.M{
+		"type": typeParam,
+		"date": bson.M{"$gte": from, "$lte": to},
+	}
+	if language != nil {
+		filter["language"] = *language
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "language", Value: 1}, {Key: "date", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, mapMongoError(err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var snapshots []*entity.CompletionSnapshot
+	if err := cursor.All(ctx, &snapshots); err != nil {
+		return nil, mapMongoError(err)
+	}
+	return snapshots, nil
+}
+
+// EnsureIndexes keeps a single snapshot per type, language and day, which
+// also serves the range reads of a type.
+func (r *completionSnapshotRepositoryMongo) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "type", Value: 1}, {Key: "language", Value: 1}, {Key: "date", Value: 1}},
+		Options: options.Index().SetName("completion_snapshots_type_language_date").SetUnique(true),
+	})
+	return mapMongoError(err)
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	libs_errors "wiki-service/pkg/libs/errors"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// mapMongoError turns driver errors into typed application errors so the
+// HTTP layer can answer with a meaningful status.
+func mapMongoError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if mongo.IsDuplicateKeyError(err) {
+		return &libs_errors.AppError{
+			Kind:    libs_errors.KindConflict,
+			Code:    libs_errors.CodeConflict,
+			Message: "resource already exists",
+			Err:     err,
+		}
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || mongo.IsTimeout(err) {
+		return libs_errors.Internal("database operation timed out", err)
+	}
+
+	return libs_errors.Internal("database operation failed", err)
+}
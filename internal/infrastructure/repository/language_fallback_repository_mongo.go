@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/domain/repository"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type languageFallbackRepositoryMongo struct {
+	collection *mongo.Collection
+}
+
+func NewLanguageFallbackRepositoryMongo(db *mongo.Database) repository.LanguageFallbackRepository {
+	return &languageFallbackRepositoryMongo{
+		collection: db.Collection("language_fallbacks"),
+	}
+}
+
+func (r *languageFallbackRepositoryMongo) GetAll(ctx context.Context) ([]*entity.LanguageFallback, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "scope", Value: 1}, {Key: "scope_id", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, mapMongoError(err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var fallbacks []*entity.LanguageFallback
+	if err := cursor.All(ctx, &fallbacks); err != nil {
+		return nil, mapMongoError(err)
+	}
+	return fallbacks, nil
+}
+
+// Upsert stores the chain for fallback.Scope/ScopeID, replacing any chain
+// already configured for that scope.
+func (r *languageFallbackRepositoryMongo) Upsert(ctx context.Context, fallback *entity.LanguageFallback) error {
+	filter := bson.M{
+		"scope":    fallback.Scope,
+		"scope_id": fallback.ScopeID,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"languages":       fallback.Languages,
+			"first_available": fallback.FirstAvailable,
+			"updated_by":      fallback.UpdatedBy,
+			"updated_at":      fallback.UpdatedAt,
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return mapMongoError(err)
+}
+
+func (r *languageFallbackRepositoryMongo) Delete(ctx context.Context, scope entity.FallbackScope, scopeID string) (bool, error) {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"scope": scope, "scope_id": scopeID})
+	if err != nil {
+		return false, mapMongoError(err)
+	}
+	return result.DeletedCount > 0, nil
+}
+
+// EnsureIndexes keeps a single chain per scope, so concurrent upserts of
+// the same scope cannot create two.
+func (r *languageFallbackRepositoryMongo) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "scope", Value: 1}, {Key: "scope_id", Value: 1}},
+		Options: options.Index().SetName("language_fallbacks_scope").SetUnique(true),
+	})
+	return mapMongoError(err)
+}
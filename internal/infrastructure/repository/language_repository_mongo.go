@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/domain/repository"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type languageRepositoryMongo struct {
+	collection *mongo.Collection
+}
+
+func NewLanguageRepositoryMongo(db *mongo.Database) repository.LanguageRepository {
+	return &languageRepositoryMongo{
+		collection: db.Collection("languages"),
+	}
+}
+
+func (r *languageRepositoryMongo) GetAll(ctx context.Context) ([]*entity.Language, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, mapMongoError(err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var languages []*entity.Language
+	if err := cursor.All(ctx, &languages); err != nil {
+		return nil, mapMongoError(err)
+	}
+	return languages, nil
+}
+
+func (r *languageRepositoryMongo) GetByID(ctx context.Context, id int) (*entity.Language, error) {
+	var language entity.Language
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&language)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, mapMongoError(err)
+	}
+	return &language, nil
+}
+
+func (r *languageRepositoryMongo) Upsert(ctx context.Context, language *entity.Language) error {
+	update := bson.M{
+		"$set": bson.M{
+			"code":       language.Code,
+			"name":       language.Name,
+			"direction":  language.Direction,
+			"enabled":    language.Enabled,
+			"updated_at": language.UpdatedAt,
+		},
+		"$setOnInsert": bson.M{"created_at": language.CreatedAt},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": language.ID}, update, options.Update().SetUpsert(true))
+	return mapMongoError(err)
+}
+
+// SeedDefaults inserts the given languages unless an entry with the same ID
+// already exists; existing entries are never overwritten. It returns the
+// IDs it inserted.
+func (r *languageRepositoryMongo) SeedDefaults(ctx context.Context, languages []entity.Language) ([]int, error) {
+	now := time.Now()
+	var inserted []int
+	for _, language := range languages {
+		language.CreatedAt = now
+		language.UpdatedAt = now
+
+		result, err := r.collection.UpdateOne(ctx,
+			bson.M{"_id": language.ID},
+			bson.M{"$setOnInsert": language},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			return inserted, mapMongoError(err)
+		}
+		if result.UpsertedCount == 1 {
+			inserted = append(inserted, language.ID)
+		}
+	}
+	return inserted, nil
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/domain/repository"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type reviewRepositoryMongo struct {
+	collection *mongo.Collection
+}
+
+func NewReviewRepositoryMongo(db *mongo.Database) repository.ReviewRepository {
+	return &reviewRepositoryMongo{
+		collection: db.Collection("wiki_reviews"),
+	}
+}
+
+func (r *reviewRepositoryMongo) Create(ctx context.Context, review *entity.ReviewRequest) error {
+	result, err := r.collection.InsertOne(ctx, review)
+	if err != nil {
+		return mapMongoError(err)
+	}
+
+	if id, ok := result.InsertedID.(primitive.ObjectID); ok {
+		review.ID = id
+	}
+	return nil
+}
+
+func (r *reviewRepositoryMongo) GetByID(ctx context.Context, id primitive.ObjectID) (*entity.ReviewRequest, error) {
+	filter := bson.M{
+		"_id": id,
+	}
+
+	var review entity.ReviewRequest
+	if err := r.collection.FindOne(ctx, filter).Decode(&review); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &review, nil
+}
+
+func (r *reviewRepositoryMongo) GetPendingByTranslation(ctx context.Context, wikiID primitive.ObjectID, language int) (*entity.ReviewRequest, error) {
+	filter := bson.M{
+		"wiki_id":  wikiID,
+		"language": language,
+		"status":   entity.ReviewStatusPending,
+	}
+
+	var review entity.ReviewRequest
+	if err := r.collection.FindOne(ctx, filter).Decode(&review); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &review, nil
+}
+
+func (r *reviewRepositoryMongo) GetQueue(ctx context.Context, reviewerID string, page, limit int) ([]*entity.ReviewRequest, int64, error) {
+	// Only reviews still waiting on this reviewer's own decision
+	filter := bson.M{
+		"status": entity.ReviewStatusPending,
+		"reviewers": bson.M{
+			"$elemMatch": bson.M{
+				"user_id":  reviewerID,
+				"decision": entity.ReviewDecisionNone,
+			},
+		},
+	}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, mapMongoError(err)
+	}
+
+	findOptions := options.Find().
+		SetSkip(int64((page - 1) * limit)).
+		SetLimit(int64(limit)).
+		SetSort(bson.M{"created_at": 1})
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	reviews := make([]*entity.ReviewRequest, 0, limit)
+	for cursor.Next(ctx) {
+		var review entity.ReviewRequest
+		if err := cursor.Decode(&review); err != nil {
+			return nil, 0, err
+		}
+		reviews = append(reviews, &review)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return reviews, total, nil
+}
+
+func (r *reviewRepositoryMongo) RecordDecision(ctx context.Context, id primitive.ObjectID, reviewer entity.Reviewer) (bool, error) {
+	filter := bson.M{
+		"_id":    id,
+		"status": entity.ReviewStatusPending,
+		"reviewers": bson.M{
+			"$elemMatch": bson.M{
+				"user_id":  reviewer.UserID,
+				"decision": entity.ReviewDecisionNone,
+			},
+		},
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"reviewers.$.decision":   reviewer.Decision,
+			"reviewers.$.comment":    reviewer.Comment,
+			"reviewers.$.decided_at": reviewer.DecidedAt,
+			"updated_at":             reviewer.DecidedAt,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, mapMongoError(err)
+	}
+	return result.MatchedCount == 1, nil
+}
+
+func (r *reviewRepositoryMongo) Resolve(ctx context.Context, id primitive.ObjectID, from, to entity.ReviewStatus, at time.Time) (bool, error) {
+	filter := bson.M{
+		"_id":    id,
+		"status": from,
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"status":      to,
+			"resolved_at": at,
+			"updated_at":  at,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, mapMongoError(err)
+	}
+	return result.MatchedCount == 1, nil
+}
+
+// EnsureIndexes makes a pending review unique per translation.
+func (r *reviewRepositoryMongo) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "wiki_id", Value: 1}, {Key: "language", Value: 1}},
+		Options: options.Index().
+			SetName("wiki_reviews_pending_translation").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"status": entity.ReviewStatusPending}),
+	})
+	return mapMongoError(err)
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"wiki-service/internal/domain/entity"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Element types whose content used to be kept in value: JSON for titles,
+// buttons and links, a key for media.
+const (
+	legacyJSONElementTypes  = "^(title|button|button_url)$"
+	legacyMediaElementTypes = "^(picture|large_picture|banner|linked_in|graphic|document|video)$"
+)
+
+// MigrateElementPayloads converts wikis written before typed element
+// payloads, moving structured content out of element values in both the
+// working and the published copies. Each wiki is only rewritten if nobody
+// saved it meanwhile; a skipped wiki was saved in the new form anyway.
+// Converted wikis get their search documents rewritten, since the text of
+// typed payloads is read differently. Only JSON values and media keys are
+// looked at, so values that stay text, such as a plain title, are not
+// matched again on every run. It returns how many wikis were converted.
+func (r *wikiRepositoryMongo) MigrateElementPayloads(ctx context.Context) (int, error) {
+	legacy := bson.M{"$elemMatch": bson.M{"$or": bson.A{
+		bson.M{
+			"type":  bson.M{"$regex": legacyJSONElementTypes, "$options": "i"},
+			"value": bson.M{"$regex": `^\s*\{`},
+		},
+		bson.M{
+			"type":  bson.M{"$regex": legacyMediaElementTypes, "$options": "i"},
+			"value": bson.M{"$type": "string", "$ne": ""},
+		},
+	}}}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"$or": bson.A{
+		bson.M{"translation.elements": legacy},
+		bson.M{"translation.published.elements": legacy},
+	}})
+	if err != nil {
+		return 0, mapMongoError(err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	migrated := 0
+	for cursor.Next(ctx) {
+		var wiki entity.Wiki
+		if err := cursor.Decode(&wiki); err != nil {
+			return migrated, mapMongoError(err)
+		}
+
+		changed := false
+		for i := range wiki.Translation {
+			translation := &wiki.Translation[i]
+			changed = migrateElements(translation.Elements) || changed
+			if translation.Published != nil {
+				changed = migrateElements(translation.Published.Elements) || changed
+			}
+		}
+		if !changed {
+			continue
+		}
+
+		result, err := r.collection.UpdateOne(ctx,
+			bson.M{"_id": wiki.ID, "updated_at": wiki.UpdatedAt},
+			bson.M{"$set": bson.M{"translation": wiki.Translation}},
+		)
+		if err != nil {
+			return migrated, mapMongoError(err)
+		}
+		if result.MatchedCount == 1 {
+			migrated++
+			r.syncSearchDocuments(ctx, &wiki)
+		}
+	}
+
+	return migrated, mapMongoError(cursor.Err())
+}
+
+func migrateElements(elements []entity.Element) bool {
+	changed := false
+	for i := range elements {
+		changed = elements[i].MigratePayload() || changed
+	}
+	return changed
+}
@@ -0,0 +1,378 @@
+package repository
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/domain/repository"
+	libs_errors "wiki-service/pkg/libs/errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// wikiFilterQuery builds the match for filter over live wikis. prefix is
+// prepended to every field so the same filter applies to wikis embedded
+// under another document, e.g. after a $lookup.
+func wikiFilterQuery(filter repository.WikiFilter, prefix string) bson.M {
+	query := bson.M{
+		prefix + "type":       filter.Type,
+		prefix + "deleted_at": nil,
+	}
+
+	if filter.Public != nil {
+		query[prefix+"public"] = *filter.Public
+	}
+	if filter.CreatedBy != "" {
+		query[prefix+"created_by"] = filter.CreatedBy
+	}
+
+	if filter.UpdatedFrom != nil || filter.UpdatedTo != nil {
+		updatedAt := bson.M{}
+		if filter.UpdatedFrom != nil {
+			updatedAt["$gte"] = *filter.UpdatedFrom
+		}
+		if filter.UpdatedTo != nil {
+			updatedAt["$lte"] = *filter.UpdatedTo
+		}
+		query[prefix+"updated_at"] = updatedAt
+	}
+
+	var and bson.A
+	if filter.Level != nil || filter.Unit != "" {
+		translation := bson.M{}
+		if filter.Language != nil {
+			translation["language"] = *filter.Language
+		}
+		if filter.Level != nil {
+			translation["level"] = *filter.Level
+		}
+		if filter.Unit != "" {
+			translation["unit"] = filter.Unit
+		}
+		and = append(and, bson.M{prefix + "translation": bson.M{"$elemMatch": translation}})
+	}
+	if filter.WithLanguage != nil {
+		and = append(and, bson.M{prefix + "translation.language": *filter.WithLanguage})
+	}
+	if filter.WithoutLanguage != nil {
+		and = append(and, bson.M{prefix + "translation.language": bson.M{"$ne": *filter.WithoutLanguage}})
+	}
+	if len(and) > 0 {
+		query["$and"] = and
+	}
+
+	return query
+}
+
+// sortField is one key of a listing order; direction is 1 or -1.
+type sortField struct {
+	path      string
+	direction int
+}
+
+// wikiSort is the full order of a listing. Fields always end with code and
+// _id so every wiki has a distinct position, which keyset cursors need.
+// addFields materialises computed sort keys before sorting; key identifies
+// the order so a cursor cannot be replayed against a different one.
+type wikiSort struct {
+	key       string
+	addFields bson.M
+	fields    []sortField
+}
+
+// wikiSortFor resolves filter.Sort for wikis found under prefix. With no
+// sort field, search results (relevance) go by their text score and
+// listings by code.
+func wikiSortFor(filter repository.WikiFilter, prefix string, relevance bool) wikiSort {
+	direction, suffix := 1, ":asc"
+	if filter.Sort.Descending {
+		direction, suffix = -1, ":desc"
+	}
+	tiebreak := []sortField{{prefix + "code", 1}, {prefix + "_id", 1}}
+
+	switch filter.Sort.Field {
+	case repository.WikiSortUpdatedAt:
+		return wikiSort{
+			key:    repository.WikiSortUpdatedAt + suffix,
+			fields: append([]sortField{{prefix + "updated_at", direction}}, tiebreak...),
+		}
+	case repository.WikiSortTitle:
+		// Without a language, order by the first title in sort direction,
+		// which is how Mongo orders array fields
+		title := bson.M{"$min": "$" + prefix + "translation.title"}
+		if direction < 0 {
+			title = bson.M{"$max": "$" + prefix + "translation.title"}
+		}
+		key := repository.WikiSortTitle + suffix
+		if filter.Language != nil {
+			title = bson.M{"$arrayElemAt": bson.A{
+				bson.M{"$map": bson.M{
+					"input": bson.M{"$filter": bson.M{
+						"input": "$" + prefix + "translation",
+						"as":    "t",
+						"cond":  bson.M{"$eq": bson.A{"$$t.language", *filter.Language}},
+					}},
+					"as": "t",
+					"in": "$$t.title",
+				}},
+				0,
+			}}
+			key += ":" + strconv.Itoa(*filter.Language)
+		}
+		return wikiSort{
+			key:       key,
+			addFields: bson.M{"sort_title": title},
+			fields:    append([]sortField{{"sort_title", direction}}, tiebreak...),
+		}
+	case repository.WikiSortCode:
+		return wikiSort{
+			key:    repository.WikiSortCode + suffix,
+			fields: []sortField{{prefix + "code", direction}, {prefix + "_id", direction}},
+		}
+	}
+
+	if relevance {
+		return wikiSort{
+			key:    "relevance",
+			fields: append([]sortField{{"score", -1}}, tiebreak...),
+		}
+	}
+	return wikiSort{
+		key:    repository.WikiSortCode + ":asc",
+		fields: tiebreak,
+	}
+}
+
+// wikiCursor marks a position in a listing: the sort values of the wiki
+// at the edge of a page, and whether to read the page before it.
+type wikiCursor struct {
+	Sort   string `bson:"s"`
+	Before bool   `bson:"b"`
+	Values bson.A `bson:"v"`
+}
+
+// encodeWikiCursor returns the opaque form of cursor, or "" when it cannot
+// be encoded, which simply ends paging in that direction.
+func encodeWikiCursor(cursor wikiCursor) string {
+	raw, err := bson.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeWikiCursor parses an opaque cursor and checks it was issued for
+// the same order.
+func decodeWikiCursor(token string, order wikiSort) (*wikiCursor, error) {
+	invalid := libs_errors.InvalidField("cursor", libs_errors.FieldInvalidFormat, "cursor is invalid", nil)
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+
+	var cursor wikiCursor
+	if err := bson.Unmarshal(raw, &cursor); err != nil {
+		return nil, invalid
+	}
+	if cursor.Sort != order.key || len(cursor.Values) != len(order.fields) {
+		return nil, invalid
+	}
+	return &cursor, nil
+}
+
+// pageStages builds the keyset match, sort, skip and limit for one page.
+// One extra document is read to tell whether more follow. Reading before a
+// cursor sorts in reverse; readWikiPage flips the page back. Computed sort
+// keys stay on the documents so the page's cursors can be read from them.
+func pageStages(order wikiSort, cursor *wikiCursor, page repository.WikiPageRequest) []bson.D {
+	var stages []bson.D
+	if order.addFields != nil {
+		stages = append(stages, bson.D{{Key: "$addFields", Value: order.addFields}})
+	}
+
+	reverse := cursor != nil && cursor.Before
+	if cursor != nil {
+		stages = append(stages, bson.D{{Key: "$match", Value: keysetMatch(order, cursor.Values, reverse)}})
+	}
+
+	sort := bson.D{}
+	for _, field := range order.fields {
+		direction := field.direction
+		if reverse {
+			direction = -direction
+		}
+		sort = append(sort, bson.E{Key: field.path, Value: direction})
+	}
+	stages = append(stages, bson.D{{Key: "$sort", Value: sort}})
+
+	if cursor == nil && page.Page > 1 {
+		stages = append(stages, bson.D{{Key: "$skip", Value: (page.Page - 1) * page.Limit}})
+	}
+	return append(stages, bson.D{{Key: "$limit", Value: page.Limit + 1}})
+}
+
+// keysetMatch keeps documents strictly after values in the order (before
+// them when reverse). It is a plain query rather than an expression so the
+// sort indexes can serve it. $sort puts null and missing keys below every
+// other value; the range clauses account for that, since query operators
+// never match null against a value of another type.
+func keysetMatch(order wikiSort, values bson.A, reverse bool) bson.M {
+	or := bson.A{}
+	for i, field := range order.fields {
+		direction := field.direction
+		if reverse {
+			direction = -direction
+		}
+
+		clause := bson.M{}
+		for j := 0; j < i; j++ {
+			// A null value matches null and missing keys alike, as $sort
+			// treats them
+			clause[order.fields[j].path] = values[j]
+		}
+
+		switch {
+		case direction > 0 && values[i] == nil:
+			clause[field.path] = bson.M{"$ne": nil}
+		case direction > 0:
+			clause[field.path] = bson.M{"$gt": values[i]}
+		case values[i] == nil:
+			// Nothing sorts below null
+			continue
+		default:
+			clause["$or"] = bson.A{
+				bson.M{field.path: bson.M{"$lt": values[i]}},
+				bson.M{field.path: nil},
+			}
+		}
+		or = append(or, clause)
+	}
+	return bson.M{"$or": or}
+}
+
+// readWikiPage turns the documents of one page into wikis and cursors.
+// docs holds up to limit+1 documents in read order; wikiPath locates the
+// wiki inside each document ("" when the document is the wiki itself).
+func readWikiPage(docs []bson.Raw, order wikiSort, cursor *wikiCursor, page repository.WikiPageRequest, wikiPath string) (*repository.WikiPage, error) {
+	hasMore := len(docs) > page.Limit
+	if hasMore {
+		docs = docs[:page.Limit]
+	}
+
+	reverse := cursor != nil && cursor.Before
+	if reverse {
+		for i, j := 0, len(docs)-1; i < j; i, j = i+1, j-1 {
+			docs[i], docs[j] = docs[j], docs[i]
+		}
+	}
+
+	result := &repository.WikiPage{Wikis: make([]*entity.Wiki, 0, len(docs))}
+	for _, doc := range docs {
+		wikiDoc := doc
+		if wikiPath != "" {
+			value, err := doc.LookupErr(wikiPath)
+			if err != nil {
+				continue
+			}
+			wikiDoc = value.Document()
+		}
+
+		var wiki entity.Wiki
+		if err := bson.Unmarshal(wikiDoc, &wiki); err != nil {
+			return nil, mapMongoError(err)
+		}
+		result.Wikis = append(result.Wikis, &wiki)
+	}
+
+	if len(docs) == 0 {
+		return result, nil
+	}
+
+	var hasPrev, hasNext bool
+	switch {
+	case cursor == nil:
+		hasPrev, hasNext = page.Page > 1, hasMore
+	case cursor.Before:
+		hasPrev, hasNext = hasMore, true
+	default:
+		hasPrev, hasNext = true, hasMore
+	}
+
+	if hasPrev {
+		result.PrevCursor = encodeWikiCursor(wikiCursor{Sort: order.key, Before: true, Values: sortValues(docs[0], order)})
+	}
+	if hasNext {
+		result.NextCursor = encodeWikiCursor(wikiCursor{Sort: order.key, Values: sortValues(docs[len(docs)-1], order)})
+	}
+	return result, nil
+}
+
+// sortValues reads the sort key of doc; missing keys are null, as in $sort.
+func sortValues(doc bson.Raw, order wikiSort) bson.A {
+	values := make(bson.A, 0, len(order.fields))
+	for _, field := range order.fields {
+		value, err := doc.LookupErr(strings.Split(field.path, ".")...)
+		if err != nil {
+			values = append(values, nil)
+			continue
+		}
+		values = append(values, value)
+	}
+	return values
+}
+
+// wikiProjection loads only what fields needs of wikis under prefix, plus
+// what every read relies on: identity, translation languages and publish
+// state (so readers still get the published copy) and the sort keys of
+// order (so cursors can be read). It returns nil when fields is nil.
+func wikiProjection(fields entity.WikiFields, order wikiSort, prefix string) bson.M {
+	if fields == nil {
+		return nil
+	}
+
+	projection := bson.M{
+		prefix + "_id":                                1,
+		prefix + "type":                               1,
+		prefix + "code":                               1,
+		prefix + "translation.language":               1,
+		prefix + "translation.status":                 1,
+		prefix + "translation.published.published_at": 1,
+	}
+
+	fieldPaths := map[string][]string{
+		entity.WikiFieldPublic:      {"public"},
+		entity.WikiFieldImage:       {"image_wiki"},
+		entity.WikiFieldPublishAt:   {"publish_at"},
+		entity.WikiFieldUnpublishAt: {"unpublish_at"},
+		entity.WikiFieldCreatedBy:   {"created_by"},
+		entity.WikiFieldUpdatedBy:   {"updated_by"},
+		entity.WikiFieldCreatedAt:   {"created_at"},
+		entity.WikiFieldUpdatedAt:   {"updated_at"},
+		entity.WikiFieldTitle:       {"translation.title", "translation.published.title"},
+		entity.WikiFieldKeywords:    {"translation.keywords", "translation.published.keywords"},
+		entity.WikiFieldLevel:       {"translation.level", "translation.published.level"},
+		entity.WikiFieldUnit:        {"translation.unit", "translation.published.unit"},
+		entity.WikiFieldElements:    {"translation.elements", "translation.published.elements"},
+		entity.WikiFieldStatus: {
+			"translation.publish_at",
+			"translation.unpublish_at",
+			"translation.machine_translated",
+			"translation.published.published_by",
+		},
+	}
+	for field, paths := range fieldPaths {
+		if !fields[field] {
+			continue
+		}
+		for _, path := range paths {
+			projection[prefix+path] = 1
+		}
+	}
+
+	for _, field := range order.fields {
+		projection[field.path] = 1
+	}
+	return projection
+}
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	"wiki-service/internal/domain/repository"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestWikiCursorRoundTrip(t *testing.T) {
+	id := primitive.NewObjectID()
+	updatedAt := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		filter     repository.WikiFilter
+		doc        bson.M
+		before     bool
+		wantValues bson.A
+	}{
+		{
+			name:       "code order",
+			doc:        bson.M{"_id": id, "code": "W1"},
+			wantValues: bson.A{"W1", id},
+		},
+		{
+			name:       "updated_at order, reading backwards",
+			filter:     repository.WikiFilter{Sort: repository.WikiSort{Field: repository.WikiSortUpdatedAt, Descending: true}},
+			doc:        bson.M{"_id": id, "code": "W1", "updated_at": updatedAt},
+			before:     true,
+			wantValues: bson.A{primitive.NewDateTimeFromTime(updatedAt), "W1", id},
+		},
+		{
+			name:       "a missing title sorts as null",
+			filter:     repository.WikiFilter{Sort: repository.WikiSort{Field: repository.WikiSortTitle}},
+			doc:        bson.M{"_id": id, "code": "W1"},
+			wantValues: bson.A{nil, "W1", id},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := wikiSortFor(tt.filter, "", false)
+			raw, err := bson.Marshal(tt.doc)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			token := encodeWikiCursor(wikiCursor{Sort: order.key, Before: tt.before, Values: sortValues(raw, order)})
+			if token == "" {
+				t.Fatalf("encodeWikiCursor() returned no cursor")
+			}
+
+			cursor, err := decodeWikiCursor(token, order)
+			if err != nil {
+				t.Fatalf("decodeWikiCursor() error = %v", err)
+			}
+			if cursor.Before != tt.before {
+				t.Errorf("Before = %v, want %v", cursor.Before, tt.before)
+			}
+			if !reflect.DeepEqual(cursor.Values, tt.wantValues) {
+				t.Errorf("Values = %#v, want %#v", cursor.Values, tt.wantValues)
+			}
+		})
+	}
+}
+
+func TestDecodeWikiCursorRejects(t *testing.T) {
+	codeOrder := wikiSortFor(repository.WikiFilter{}, "", false)
+	updatedOrder := wikiSortFor(repository.WikiFilter{Sort: repository.WikiSort{Field: repository.WikiSortUpdatedAt}}, "", false)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "not base64", token: "%%%"},
+		{name: "not a cursor", token: "aGVsbG8"},
+		{name: "issued for another order", token: encodeWikiCursor(wikiCursor{Sort: updatedOrder.key, Values: bson.A{nil, "W1", primitive.NewObjectID()}})},
+		{name: "wrong number of values", token: encodeWikiCursor(wikiCursor{Sort: codeOrder.key, Values: bson.A{"W1"}})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodeWikiCursor(tt.token, codeOrder); err == nil {
+				t.Errorf("decodeWikiCursor() accepted %q", tt.token)
+			}
+		})
+	}
+}
+
+func TestKeysetMatch(t *testing.T) {
+	id := primitive.NewObjectID()
+	titleAsc := wikiSortFor(repository.WikiFilter{Sort: repository.WikiSort{Field: repository.WikiSortTitle}}, "", false)
+	titleDesc := wikiSortFor(repository.WikiFilter{Sort: repository.WikiSort{Field: repository.WikiSortTitle, Descending: true}}, "", false)
+
+	tests := []struct {
+		name    string
+		order   wikiSort
+		values  bson.A
+		reverse bool
+		want    bson.M
+	}{
+		{
+			name:   "ascending keys compare with $gt",
+			order:  wikiSortFor(repository.WikiFilter{}, "", false),
+			values: bson.A{"W1", id},
+			want: bson.M{"$or": bson.A{
+				bson.M{"code": bson.M{"$gt": "W1"}},
+				bson.M{"code": "W1", "_id": bson.M{"$gt": id}},
+			}},
+		},
+		{
+			name:   "descending keys also match null, which sorts last",
+			order:  titleDesc,
+			values: bson.A{"B", "W1", id},
+			want: bson.M{"$or": bson.A{
+				bson.M{"$or": bson.A{
+					bson.M{"sort_title": bson.M{"$lt": "B"}},
+					bson.M{"sort_title": nil},
+				}},
+				bson.M{"sort_title": "B", "code": bson.M{"$gt": "W1"}},
+				bson.M{"sort_title": "B", "code": "W1", "_id": bson.M{"$gt": id}},
+			}},
+		},
+		{
+			name:   "after a null ascending key comes any value",
+			order:  titleAsc,
+			values: bson.A{nil, "W1", id},
+			want: bson.M{"$or": bson.A{
+				bson.M{"sort_title": bson.M{"$ne": nil}},
+				bson.M{"sort_title": nil, "code": bson.M{"$gt": "W1"}},
+				bson.M{"sort_title": nil, "code": "W1", "_id": bson.M{"$gt": id}},
+			}},
+		},
+		{
+			name:    "nothing comes before a null key",
+			order:   titleAsc,
+			values:  bson.A{nil, "W1", id},
+			reverse: true,
+			want: bson.M{"$or": bson.A{
+				bson.M{"sort_title": nil, "$or": bson.A{
+					bson.M{"code": bson.M{"$lt": "W1"}},
+					bson.M{"code": nil},
+				}},
+				bson.M{"sort_title": nil, "code": "W1", "$or": bson.A{
+					bson.M{"_id": bson.M{"$lt": id}},
+					bson.M{"_id": nil},
+				}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := keysetMatch(tt.order, tt.values, tt.reverse); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("keysetMatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
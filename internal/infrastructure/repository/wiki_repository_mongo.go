@@ -2,8 +2,12 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"time"
 	"wiki-service/internal/domain/entity"
 	"wiki-service/internal/domain/repository"
+	libs_search "wiki-service/pkg/libs/search"
+	"wiki-service/pkg/logger"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -14,12 +18,16 @@ import (
 type wikiRepositoryMongo struct {
 	collection         *mongo.Collection
 	templateCollection *mongo.Collection
+	searchCollection   *mongo.Collection
+	logger             *logger.Logger
 }
 
-func NewWikiRepositoryMongo(db *mongo.Database) repository.WikiRepository {
+func NewWikiRepositoryMongo(db *mongo.Database, logger *logger.Logger) repository.WikiRepository {
 	return &wikiRepositoryMongo{
 		collection:         db.Collection("wikis"),
 		templateCollection: db.Collection("wiki_templates"),
+		searchCollection:   db.Collection("wiki_search"),
+		logger:             logger,
 	}
 }
 
@@ -32,7 +40,7 @@ func (r *wikiRepositoryMongo) CreateTemplate(ctx context.Context, template *enti
 	}
 
 	_, err := r.templateCollection.InsertOne(ctx, template)
-	return err
+	return mapMongoError(err)
 }
 
 func (r *wikiRepositoryMongo) GetTemplates(ctx context.Context, typeParam string) (*entity.WikiTemplate, error) {
@@ -52,6 +60,42 @@ func (r *wikiRepositoryMongo) GetTemplates(ctx context.Context, typeParam string
 	return &template, nil
 }
 
+// GetTemplateTypes returns every type that has a template.
+func (r *wikiRepositoryMongo) GetTemplateTypes(ctx context.Context) ([]string, error) {
+	values, err := r.templateCollection.Distinct(ctx, "type", bson.M{})
+	if err != nil {
+		return nil, mapMongoError(err)
+	}
+
+	types := make([]string, 0, len(values))
+	for _, value := range values {
+		if typeParam, ok := value.(string); ok && typeParam != "" {
+			types = append(types, typeParam)
+		}
+	}
+	return types, nil
+}
+
+// GetTranslationLanguages returns every language ID stored translations
+// use, trashed wikis included.
+func (r *wikiRepositoryMongo) GetTranslationLanguages(ctx context.Context) ([]int, error) {
+	values, err := r.collection.Distinct(ctx, "translation.language", bson.M{})
+	if err != nil {
+		return nil, mapMongoError(err)
+	}
+
+	languages := make([]int, 0, len(values))
+	for _, value := range values {
+		switch language := value.(type) {
+		case int32:
+			languages = append(languages, int(language))
+		case int64:
+			languages = append(languages, int(language))
+		}
+	}
+	return languages, nil
+}
+
 func (r *wikiRepositoryMongo) CreateMany(ctx context.Context, wikis []entity.Wiki, typeParam string) error {
 	filter := bson.M{
 		"type": typeParam,
@@ -59,70 +103,92 @@ func (r *wikiRepositoryMongo) CreateMany(ctx context.Context, wikis []entity.Wik
 	if _, err := r.collection.DeleteMany(ctx, filter); err != nil {
 		return err
 	}
+	r.deleteSearchDocuments(ctx, filter)
 
 	docs := make([]interface{}, len(wikis))
 	for i, wiki := range wikis {
 		docs[i] = wiki
 	}
 
-	_, err := r.collection.InsertMany(ctx, docs)
-	return err
+	result, err := r.collection.InsertMany(ctx, docs)
+	if err != nil {
+		return mapMongoError(err)
+	}
+
+	// The type's search documents were cleared above, so the new ones are
+	// written in one batch rather than synced wiki by wiki
+	var searchDocs []wikiSearchDocument
+	for i := range wikis {
+		if id, ok := result.InsertedIDs[i].(primitive.ObjectID); ok {
+			wikis[i].ID = id
+			searchDocs = append(searchDocs, searchDocuments(&wikis[i])...)
+		}
+	}
+	if err := r.insertSearchDocuments(ctx, searchDocs); err != nil {
+		r.logger.Error(fmt.Sprintf("failed to write search documents for type %s: %v", typeParam, err))
+	}
+	return nil
 }
 
-func (r *wikiRepositoryMongo) GetWikis(ctx context.Context, page, limit int, typeParam, search string) ([]*entity.Wiki, int64, error) {
-	filter := bson.M{
-		"type": typeParam,
+// GetWikis lists a type's wikis matching filter, by code unless another
+// sort is requested. With a search it instead ranks wikis by text score
+// over code, title, keywords and element text, limited to the filter's
+// language when one is set. Matching ignores case and diacritics, and
+// search operators in the input are neutralised.
+func (r *wikiRepositoryMongo) GetWikis(ctx context.Context, filter repository.WikiFilter, page repository.WikiPageRequest) (*repository.WikiPage, error) {
+	if query := libs_search.TextQuery(filter.Search); query != "" {
+		return r.searchWikis(ctx, filter, query, page)
 	}
 
-	if search != "" {
-		searchRegex := bson.M{"$regex": search, "$options": "i"}
-		filter["$or"] = bson.A{
-			bson.M{"code": searchRegex},
-			bson.M{"translation.title": searchRegex},
+	order := wikiSortFor(filter, "", false)
+	var cursor *wikiCursor
+	if page.Cursor != "" {
+		decoded, err := decodeWikiCursor(page.Cursor, order)
+		if err != nil {
+			return nil, err
 		}
+		cursor = decoded
 	}
 
-	total, err := r.collection.CountDocuments(ctx, filter)
-	if err != nil {
-		return nil, 0, err
-	}
+	match := wikiFilterQuery(filter, "")
 
-	findOptions := options.Find().
-		SetSkip(int64((page - 1) * limit)).
-		SetLimit(int64(limit)).
-		SetSort(bson.M{"code": 1})
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: match}}}
+	pipeline = append(pipeline, pageStages(order, cursor, page)...)
+	if projection := wikiProjection(page.Fields, order, ""); projection != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$project", Value: projection}})
+	}
 
-	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	aggregateCursor, err := r.collection.Aggregate(ctx, pipeline)
 	if err != nil {
-		return nil, 0, err
+		return nil, mapMongoError(err)
 	}
-	defer func() {
-		if closeErr := cursor.Close(ctx); closeErr != nil {
-			_ = closeErr 
-		}
-	}()
+	defer func() { _ = aggregateCursor.Close(ctx) }()
 
-	wikis := make([]*entity.Wiki, 0, limit)
+	var docs []bson.Raw
+	if err := aggregateCursor.All(ctx, &docs); err != nil {
+		return nil, mapMongoError(err)
+	}
 
-	for cursor.Next(ctx) {
-		var wiki entity.Wiki
-		if err := cursor.Decode(&wiki); err != nil {
-			return nil, 0, err
-		}
-		wikis = append(wikis, &wiki)
+	result, err := readWikiPage(docs, order, cursor, page, "")
+	if err != nil {
+		return nil, err
 	}
 
-	if err := cursor.Err(); err != nil {
-		return nil, 0, err
+	if page.CountTotal {
+		total, err := r.collection.CountDocuments(ctx, match)
+		if err != nil {
+			return nil, mapMongoError(err)
+		}
+		result.Total = &total
 	}
 
-	return wikis, total, nil
+	return result, nil
 }
 
 func (r *wikiRepositoryMongo) GetWikiByID(ctx context.Context, id primitive.ObjectID) (*entity.Wiki, error) {
-	filter := bson.M{
+	filter := notTrashed(bson.M{
 		"_id": id,
-	}
+	})
 
 	var wiki entity.Wiki
 	if err := r.collection.FindOne(ctx, filter).Decode(&wiki); err != nil {
@@ -135,10 +201,10 @@ func (r *wikiRepositoryMongo) GetWikiByID(ctx context.Context, id primitive.Obje
 }
 
 func (r *wikiRepositoryMongo) GetWikiByCode(ctx context.Context, code string, typeParam string) (*entity.Wiki, error) {
-	filter := bson.M{
+	filter := notTrashed(bson.M{
 		"code": code,
 		"type": typeParam,
-	}
+	})
 
 	var wiki entity.Wiki
 	err := r.collection.FindOne(ctx, filter).Decode(&wiki)
@@ -151,11 +217,226 @@ func (r *wikiRepositoryMongo) GetWikiByCode(ctx context.Context, code string, ty
 	return &wiki, nil
 }
 
+// GetWikisByCodes loads the wikis of a type with any of the codes in one
+// query. Codes without a wiki are simply absent from the result.
+func (r *wikiRepositoryMongo) GetWikisByCodes(ctx context.Context, codes []string, typeParam string) ([]*entity.Wiki, error) {
+	filter := notTrashed(bson.M{
+		"code": bson.M{"$in": codes},
+		"type": typeParam,
+	})
+
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, mapMongoError(err)
+	}
+
+	var wikis []*entity.Wiki
+	if err := cursor.All(ctx, &wikis); err != nil {
+		return nil, mapMongoError(err)
+	}
+	return wikis, nil
+}
+
 func (r *wikiRepositoryMongo) UpdateWiki(ctx context.Context, id primitive.ObjectID, wiki *entity.Wiki) error {
-	filter := bson.M{
+	filter := notTrashed(bson.M{
 		"_id": id,
+	})
+
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": wiki})
+	if err != nil {
+		return mapMongoError(err)
+	}
+
+	if result.MatchedCount == 1 {
+		wiki.ID = id
+		r.syncSearchDocuments(ctx, wiki)
+	}
+	return nil
+}
+
+// UpdateWikiIfUnchanged writes the wiki only if nobody else has updated it
+// since it was read, reporting whether the write happened.
+func (r *wikiRepositoryMongo) UpdateWikiIfUnchanged(ctx context.Context, wiki *entity.Wiki, updatedAt time.Time) (bool, error) {
+	filter := notTrashed(bson.M{
+		"_id":        wiki.ID,
+		"updated_at": updatedAt,
+	})
+
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": wiki})
+	if err != nil {
+		return false, mapMongoError(err)
 	}
 
-	_, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": wiki})
-	return err
+	if result.MatchedCount == 1 {
+		r.syncSearchDocuments(ctx, wiki)
+	}
+	return result.MatchedCount == 1, nil
+}
+
+// IterateWikis streams every live wiki of a type, ordered by code, without
+// loading the whole type into memory. Iteration stops at the first error
+// returned by fn.
+func (r *wikiRepositoryMongo) IterateWikis(ctx context.Context, typeParam string, fn func(wiki *entity.Wiki) error) error {
+	filter := notTrashed(bson.M{
+		"type": typeParam,
+	})
+
+	cursor, err := r.collection.Find(ctx, filter, options.Find().SetSort(bson.M{"code": 1}))
+	if err != nil {
+		return mapMongoError(err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	for cursor.Next(ctx) {
+		var wiki entity.Wiki
+		if err := cursor.Decode(&wiki); err != nil {
+			return mapMongoError(err)
+		}
+		if err := fn(&wiki); err != nil {
+			return err
+		}
+	}
+
+	return mapMongoError(cursor.Err())
+}
+
+func (r *wikiRepositoryMongo) GetDueSchedules(ctx context.Context, now time.Time) ([]*entity.Wiki, error) {
+	due := bson.M{"$lte": now}
+	filter := notTrashed(bson.M{
+		"$or": bson.A{
+			bson.M{"publish_at": due},
+			bson.M{"unpublish_at": due},
+			bson.M{"translation.publish_at": due},
+			bson.M{"translation.unpublish_at": due},
+		},
+	})
+
+	return r.findWikis(ctx, filter, options.Find())
+}
+
+func (r *wikiRepositoryMongo) SoftDeleteWiki(ctx context.Context, id primitive.ObjectID, userID string, now time.Time) (bool, error) {
+	filter := notTrashed(bson.M{
+		"_id": id,
+	})
+	update := bson.M{
+		"$set": bson.M{
+			"deleted_at": now,
+			"deleted_by": userID,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, mapMongoError(err)
+	}
+
+	if result.MatchedCount == 1 {
+		r.markSearchDocuments(ctx, id, true)
+	}
+	return result.MatchedCount == 1, nil
+}
+
+func (r *wikiRepositoryMongo) RestoreWiki(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	filter := trashed(bson.M{
+		"_id": id,
+	})
+	update := bson.M{
+		"$set": bson.M{
+			"deleted_at": nil,
+			"deleted_by": "",
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, mapMongoError(err)
+	}
+
+	if result.MatchedCount == 1 {
+		r.markSearchDocuments(ctx, id, false)
+	}
+	return result.MatchedCount == 1, nil
+}
+
+func (r *wikiRepositoryMongo) GetTrash(ctx context.Context, page, limit int, typeParam string) ([]*entity.Wiki, int64, error) {
+	filter := trashed(bson.M{})
+	if typeParam != "" {
+		filter["type"] = typeParam
+	}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, mapMongoError(err)
+	}
+
+	findOptions := options.Find().
+		SetSkip(int64((page - 1) * limit)).
+		SetLimit(int64(limit)).
+		SetSort(bson.M{"deleted_at": -1})
+
+	wikis, err := r.findWikis(ctx, filter, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+	return wikis, total, nil
+}
+
+func (r *wikiRepositoryMongo) GetTrashedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*entity.Wiki, error) {
+	filter := bson.M{
+		"deleted_at": bson.M{"$ne": nil, "$lte": cutoff},
+	}
+
+	return r.findWikis(ctx, filter, options.Find().SetLimit(int64(limit)))
+}
+
+// PurgeWiki permanently deletes a wiki, but only while it is still in the
+// trash since cutoff or earlier; a wiki restored meanwhile is kept. It
+// reports whether the wiki was deleted.
+func (r *wikiRepositoryMongo) PurgeWiki(ctx context.Context, id primitive.ObjectID, cutoff time.Time) (bool, error) {
+	filter := bson.M{
+		"_id":        id,
+		"deleted_at": bson.M{"$ne": nil, "$lte": cutoff},
+	}
+
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return false, mapMongoError(err)
+	}
+	if result.DeletedCount == 0 {
+		return false, nil
+	}
+
+	r.deleteSearchDocuments(ctx, bson.M{"wiki_id": id})
+	return true, nil
+}
+
+func (r *wikiRepositoryMongo) findWikis(ctx context.Context, filter bson.M, findOptions *options.FindOptions) ([]*entity.Wiki, error) {
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, mapMongoError(err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var wikis []*entity.Wiki
+	for cursor.Next(ctx) {
+		var wiki entity.Wiki
+		if err := cursor.Decode(&wiki); err != nil {
+			return nil, mapMongoError(err)
+		}
+		wikis = append(wikis, &wiki)
+	}
+
+	return wikis, mapMongoError(cursor.Err())
+}
+
+// notTrashed restricts a filter to wikis that are not in the trash. Every
+// read path must go through it; a null or missing deleted_at means live.
+func notTrashed(filter bson.M) bson.M {
+	filter["deleted_at"] = nil
+	return filter
+}
+
+func trashed(filter bson.M) bson.M {
+	filter["deleted_at"] = bson.M{"$ne": nil}
+	return filter
 }
@@ -0,0 +1,514 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/domain/repository"
+	libs_search "wiki-service/pkg/libs/search"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// wikiSearchDocument is the searchable view of one translation of a wiki.
+// Keeping one document per wiki and language lets a $text query be scoped
+// to a language, which a text index over the translation array cannot do.
+// The wiki repository rewrites these documents on every wiki write. The
+// folded fields hold the same content lowercased without diacritics and
+// are what the text index covers, so searches are accent-insensitive.
+// Suggest holds the folded terms autocomplete matches by prefix. Readers
+// search what is published; Draft documents hold the working copy and are
+// only searched by previews.
+type wikiSearchDocument struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	WikiID         primitive.ObjectID `bson:"wiki_id"`
+	Type           string             `bson:"type"`
+	Code           string             `bson:"code"`
+	Language       int                `bson:"language"`
+	Title          string             `bson:"title"`
+	Keywords       string             `bson:"keywords"`
+	Text           string             `bson:"text"`
+	CodeFolded     string             `bson:"code_folded"`
+	TitleFolded    string             `bson:"title_folded"`
+	KeywordsFolded string             `bson:"keywords_folded"`
+	TextFolded     string             `bson:"text_folded"`
+	Suggest        []string           `bson:"suggest"`
+	Deleted        bool               `bson:"deleted"`
+	Draft          bool               `bson:"draft"`
+	Version        int                `bson:"version"`
+}
+
+// searchDocumentVersion is bumped whenever the shape of search documents
+// changes; RebuildSearchDocuments rewrites documents of older versions.
+const searchDocumentVersion = 4
+
+// staleVersion matches the version of documents written by an older
+// version, including those from before documents were versioned. Newer
+// documents are left alone so older instances do not undo an upgrade.
+var staleVersion = bson.M{"$not": bson.M{"$gte": searchDocumentVersion}}
+
+// suggestCandidateLimit caps how many prefix matches SuggestWikis ranks. A
+// short prefix can match most of a type; candidates are read in index
+// order, so the shortest matching terms are kept.
+const suggestCandidateLimit = 500
+
+// searchTextIndex names the text index. A collection holds a single text
+// index, so an index under any other name is dropped before creating it.
+const searchTextIndex = "wiki_search_folded_text"
+
+// EnsureIndexes creates the indexes the wiki collections rely on. Search
+// documents are built separately by RebuildSearchDocuments.
+func (r *wikiRepositoryMongo) EnsureIndexes(ctx context.Context) error {
+	// Listing filters and sorts; every query pins type and deleted_at
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "type", Value: 1}, {Key: "deleted_at", Value: 1}, {Key: "code", Value: 1}},
+			Options: options.Index().SetName("wikis_type_code"),
+		},
+		{
+			Keys:    bson.D{{Key: "type", Value: 1}, {Key: "deleted_at", Value: 1}, {Key: "updated_at", Value: -1}, {Key: "code", Value: 1}},
+			Options: options.Index().SetName("wikis_type_updated_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "type", Value: 1}, {Key: "deleted_at", Value: 1}, {Key: "translation.title", Value: 1}, {Key: "code", Value: 1}},
+			Options: options.Index().SetName("wikis_type_title"),
+		},
+		{
+			Keys:    bson.D{{Key: "type", Value: 1}, {Key: "deleted_at", Value: 1}, {Key: "public", Value: 1}, {Key: "code", Value: 1}},
+			Options: options.Index().SetName("wikis_type_public"),
+		},
+		{
+			Keys:    bson.D{{Key: "type", Value: 1}, {Key: "deleted_at", Value: 1}, {Key: "created_by", Value: 1}, {Key: "updated_at", Value: -1}},
+			Options: options.Index().SetName("wikis_type_created_by"),
+		},
+		{
+			Keys: bson.D{
+				{Key: "type", Value: 1},
+				{Key: "deleted_at", Value: 1},
+				{Key: "translation.language", Value: 1},
+				{Key: "translation.level", Value: 1},
+				{Key: "translation.unit", Value: 1},
+			},
+			Options: options.Index().SetName("wikis_type_translation"),
+		},
+	})
+	if err != nil {
+		return mapMongoError(err)
+	}
+
+	if err := r.dropStaleTextIndexes(ctx); err != nil {
+		return err
+	}
+
+	_, err = r.searchCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "type", Value: 1},
+				{Key: "code_folded", Value: "text"},
+				{Key: "title_folded", Value: "text"},
+				{Key: "keywords_folded", Value: "text"},
+				{Key: "text_folded", Value: "text"},
+			},
+			Options: options.Index().
+				SetName(searchTextIndex).
+				SetDefaultLanguage("none").
+				SetWeights(bson.D{
+					{Key: "code_folded", Value: 10},
+					{Key: "title_folded", Value: 10},
+					{Key: "keywords_folded", Value: 5},
+					{Key: "text_folded", Value: 1},
+				}),
+		},
+		{
+			// One document per wiki, language and view
+			Keys:    bson.D{{Key: "wiki_id", Value: 1}, {Key: "language", Value: 1}, {Key: "draft", Value: 1}},
+			Options: options.Index().SetName("wiki_search_document").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "version", Value: 1}},
+			Options: options.Index().SetName("wiki_search_version"),
+		},
+		{
+			// Anchored regexes on suggest become index range scans
+			Keys: bson.D{
+				{Key: "type", Value: 1},
+				{Key: "deleted", Value: 1},
+				{Key: "suggest", Value: 1},
+				{Key: "language", Value: 1},
+			},
+			Options: options.Index().SetName("wiki_search_suggest"),
+		},
+	})
+	return mapMongoError(err)
+}
+
+// RebuildSearchDocuments rewrites the search documents of wikis indexed by
+// an older version, or of every wiki while none are indexed yet. Documents
+// are upserted in place, so searches keep working during a rebuild, and it
+// returns how many wikis were rebuilt. Run it under a lease: it is
+// idempotent but scans the wikis it rebuilds.
+func (r *wikiRepositoryMongo) RebuildSearchDocuments(ctx context.Context) (int, error) {
+	filter := bson.M{}
+
+	indexed, err := r.searchCollection.EstimatedDocumentCount(ctx)
+	if err != nil {
+		return 0, mapMongoError(err)
+	}
+	if indexed > 0 {
+		ids, err := r.searchCollection.Distinct(ctx, "wiki_id", bson.M{"version": staleVersion})
+		if err != nil {
+			return 0, mapMongoError(err)
+		}
+		if len(ids) == 0 {
+			return 0, nil
+		}
+		filter["_id"] = bson.M{"$in": ids}
+	}
+
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return 0, mapMongoError(err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	rebuilt := 0
+	for cursor.Next(ctx) {
+		var wiki entity.Wiki
+		if err := cursor.Decode(&wiki); err != nil {
+			return rebuilt, mapMongoError(err)
+		}
+		if err := r.upsertSearchDocuments(ctx, &wiki); err != nil {
+			return rebuilt, err
+		}
+		rebuilt++
+	}
+
+	return rebuilt, mapMongoError(cursor.Err())
+}
+
+// dropStaleTextIndexes removes text indexes other than searchTextIndex.
+func (r *wikiRepositoryMongo) dropStaleTextIndexes(ctx context.Context) error {
+	cursor, err := r.searchCollection.Indexes().List(ctx)
+	if err != nil {
+		return mapMongoError(err)
+	}
+
+	var indexes []struct {
+		Name string `bson:"name"`
+		Key  bson.M `bson:"key"`
+	}
+	if err := cursor.All(ctx, &indexes); err != nil {
+		return mapMongoError(err)
+	}
+
+	for _, index := range indexes {
+		if index.Name == searchTextIndex || index.Key["_fts"] == nil {
+			continue
+		}
+		if _, err := r.searchCollection.Indexes().DropOne(ctx, index.Name); err != nil {
+			return mapMongoError(err)
+		}
+	}
+	return nil
+}
+
+// searchWikis runs a $text query over the search documents of a type,
+// joins the matching wikis and applies the rest of the filter to them. The
+// page is ordered by best score across the wiki's translations unless the
+// filter asks for another sort. query must already be a folded text query.
+func (r *wikiRepositoryMongo) searchWikis(ctx context.Context, filter repository.WikiFilter, query string, page repository.WikiPageRequest) (*repository.WikiPage, error) {
+	order := wikiSortFor(filter, "wiki.", true)
+	var cursor *wikiCursor
+	if page.Cursor != "" {
+		decoded, err := decodeWikiCursor(page.Cursor, order)
+		if err != nil {
+			return nil, err
+		}
+		cursor = decoded
+	}
+
+	match := bson.M{
+		"type":    filter.Type,
+		"deleted": false,
+		"draft":   filter.Draft,
+		"$text":   bson.M{"$search": query},
+	}
+	if filter.Language != nil {
+		match["language"] = *filter.Language
+	}
+
+	items := bson.A{}
+	for _, stage := range pageStages(order, cursor, page) {
+		items = append(items, stage)
+	}
+	if projection := wikiProjection(page.Fields, order, "wiki."); projection != nil {
+		items = append(items, bson.M{"$project": projection})
+	}
+	facet := bson.M{"items": items}
+	if page.CountTotal {
+		facet["total"] = bson.A{bson.M{"$count": "count"}}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   "$wiki_id",
+			"score": bson.M{"$max": bson.M{"$meta": "textScore"}},
+		}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         r.collection.Name(),
+			"localField":   "_id",
+			"foreignField": "_id",
+			"as":           "wiki",
+		}}},
+		{{Key: "$unwind", Value: "$wiki"}},
+		{{Key: "$match", Value: wikiFilterQuery(filter, "wiki.")}},
+		{{Key: "$facet", Value: facet}},
+	}
+
+	aggregateCursor, err := r.searchCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, mapMongoError(err)
+	}
+	defer func() { _ = aggregateCursor.Close(ctx) }()
+
+	var results []struct {
+		Total []struct {
+			Count int64 `bson:"count"`
+		} `bson:"total"`
+		Items []bson.Raw `bson:"items"`
+	}
+	if err := aggregateCursor.All(ctx, &results); err != nil {
+		return nil, mapMongoError(err)
+	}
+
+	var docs []bson.Raw
+	if len(results) > 0 {
+		docs = results[0].Items
+	}
+
+	result, err := readWikiPage(docs, order, cursor, page, "wiki")
+	if err != nil {
+		return nil, err
+	}
+
+	if page.CountTotal {
+		var total int64
+		if len(results) > 0 && len(results[0].Total) > 0 {
+			total = results[0].Total[0].Count
+		}
+		result.Total = &total
+	}
+
+	return result, nil
+}
+
+// SuggestWikis returns the wikis of a type whose code, title or a keyword
+// in the given language starts with prefix, ignoring case and accents.
+// Title matches also count from the start of any word. Code matches rank
+// first, then title matches, then keyword matches. Only what readers see
+// is suggested, never the text of unpublished drafts.
+func (r *wikiRepositoryMongo) SuggestWikis(ctx context.Context, typeParam, prefix string, language, limit int) ([]*entity.WikiSuggestion, error) {
+	folded := libs_search.Fold(prefix)
+	if folded == "" {
+		return []*entity.WikiSuggestion{}, nil
+	}
+	pattern := "^" + regexp.QuoteMeta(folded)
+
+	startsWith := func(field string) bson.M {
+		return bson.M{"$regexMatch": bson.M{"input": "$" + field, "regex": pattern}}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"type":     typeParam,
+			"deleted":  false,
+			"draft":    false,
+			"suggest":  bson.M{"$regex": pattern},
+			"language": language,
+		}}},
+		{{Key: "$limit", Value: suggestCandidateLimit}},
+		{{Key: "$addFields", Value: bson.M{
+			"rank": bson.M{"$switch": bson.M{
+				"branches": bson.A{
+					bson.M{"case": startsWith("code_folded"), "then": 0},
+					bson.M{"case": startsWith("title_folded"), "then": 1},
+				},
+				"default": 2,
+			}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "rank", Value: 1}, {Key: "code_folded", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$project", Value: bson.M{"_id": 0, "wiki_id": 1, "code": 1, "language": 1, "title": 1}}},
+	}
+
+	cursor, err := r.searchCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, mapMongoError(err)
+	}
+
+	suggestions := []*entity.WikiSuggestion{}
+	if err := cursor.All(ctx, &suggestions); err != nil {
+		return nil, mapMongoError(err)
+	}
+	return suggestions, nil
+}
+
+// syncSearchDocuments rewrites the search documents of a wiki after a
+// write. Failures are logged rather than returned: the wiki itself is
+// already saved, and its next save rewrites the documents.
+func (r *wikiRepositoryMongo) syncSearchDocuments(ctx context.Context, wiki *entity.Wiki) {
+	if err := r.upsertSearchDocuments(ctx, wiki); err != nil {
+		r.logger.Error(fmt.Sprintf("failed to write search documents for wiki %s: %v", wiki.ID.Hex(), err))
+	}
+}
+
+// markSearchDocuments flags or unflags a wiki's search documents as
+// trashed.
+func (r *wikiRepositoryMongo) markSearchDocuments(ctx context.Context, id primitive.ObjectID, deleted bool) {
+	_, err := r.searchCollection.UpdateMany(ctx, bson.M{"wiki_id": id}, bson.M{"$set": bson.M{"deleted": deleted}})
+	if err != nil {
+		r.logger.Error(fmt.Sprintf("failed to update search documents for wiki %s: %v", id.Hex(), err))
+	}
+}
+
+func (r *wikiRepositoryMongo) deleteSearchDocuments(ctx context.Context, filter bson.M) {
+	if _, err := r.searchCollection.DeleteMany(ctx, filter); err != nil {
+		r.logger.Error(fmt.Sprintf("failed to delete search documents: %v", err))
+	}
+}
+
+// insertSearchDocuments writes docs with a single InsertMany.
+func (r *wikiRepositoryMongo) insertSearchDocuments(ctx context.Context, docs []wikiSearchDocument) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	items := make([]interface{}, len(docs))
+	for i, doc := range docs {
+		items[i] = doc
+	}
+	_, err := r.searchCollection.InsertMany(ctx, items)
+	return mapMongoError(err)
+}
+
+// upsertSearchDocuments writes the current search documents of a wiki over
+// the ones stored for the same language and view, then drops the others:
+// those of removed translations and any left from older versions. Writing
+// in place keeps concurrent saves from leaving duplicates behind.
+func (r *wikiRepositoryMongo) upsertSearchDocuments(ctx context.Context, wiki *entity.Wiki) error {
+	docs := searchDocuments(wiki)
+	current := bson.A{}
+	if len(docs) > 0 {
+		models := make([]mongo.WriteModel, len(docs))
+		for i, doc := range docs {
+			key := bson.M{"wiki_id": doc.WikiID, "language": doc.Language, "draft": doc.Draft}
+			models[i] = mongo.NewReplaceOneModel().
+				SetFilter(key).
+				SetReplacement(doc).
+				SetUpsert(true)
+			current = append(current, key)
+		}
+		if _, err := r.searchCollection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+			return mapMongoError(err)
+		}
+	}
+
+	obsolete := bson.M{"wiki_id": wiki.ID}
+	if len(current) > 0 {
+		obsolete["$nor"] = current
+	}
+	_, err := r.searchCollection.DeleteMany(ctx, obsolete)
+	return mapMongoError(err)
+}
+
+// searchDocuments builds the search documents of every translation that
+// has a language: one from the content readers see, which is the published
+// copy or, for legacy translations, the stored content, and one from the
+// working copy. Translations never published only get the latter.
+func searchDocuments(wiki *entity.Wiki) []wikiSearchDocument {
+	docs := make([]wikiSearchDocument, 0, 2*len(wiki.Translation))
+	for _, translation := range wiki.Translation {
+		if translation.Language == nil {
+			continue
+		}
+		language := *translation.Language
+
+		if published := translation.Published; published != nil {
+			docs = append(docs, newSearchDocument(wiki, language, published.Title, published.Keywords, published.Elements, false))
+		} else if translation.Status == "" {
+			docs = append(docs, newSearchDocument(wiki, language, translation.Title, translation.Keywords, translation.Elements, false))
+		}
+		docs = append(docs, newSearchDocument(wiki, language, translation.Title, translation.Keywords, translation.Elements, true))
+	}
+	return docs
+}
+
+func newSearchDocument(wiki *entity.Wiki, language int, title, keywords *string, elements []entity.Element, draft bool) wikiSearchDocument {
+	doc := wikiSearchDocument{
+		WikiID:   wiki.ID,
+		Type:     wiki.Type,
+		Code:     wiki.Code,
+		Language: language,
+		Title:    stringOrEmpty(title),
+		Keywords: stringOrEmpty(keywords),
+		Text:     translationText(elements),
+		Deleted:  wiki.DeletedAt != nil,
+		Draft:    draft,
+		Version:  searchDocumentVersion,
+	}
+	doc.CodeFolded = libs_search.Fold(doc.Code)
+	doc.TitleFolded = libs_search.Fold(doc.Title)
+	doc.KeywordsFolded = libs_search.Fold(doc.Keywords)
+	doc.TextFolded = libs_search.Fold(doc.Text)
+	doc.Suggest = suggestTerms(doc)
+	return doc
+}
+
+// translationText joins the visible text of a translation's elements.
+func translationText(elements []entity.Element) string {
+	var parts []string
+	for _, elem := range elements {
+		parts = append(parts, elem.VisibleText()...)
+	}
+	return strings.Join(parts, "\n")
+}
+
+// suggestTerms lists the folded terms a prefix can match: the code, the
+// title from the start of each of its words, and each comma-separated
+// keyword.
+func suggestTerms(doc wikiSearchDocument) []string {
+	seen := map[string]bool{}
+	terms := []string{}
+	add := func(term string) {
+		term = strings.TrimSpace(term)
+		if term != "" && !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+
+	add(doc.CodeFolded)
+
+	words := strings.Fields(doc.TitleFolded)
+	for i := range words {
+		add(strings.Join(words[i:], " "))
+	}
+
+	for _, keyword := range strings.Split(doc.KeywordsFolded, ",") {
+		add(keyword)
+	}
+	return terms
+}
+
+func stringOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"wiki-service/internal/domain/entity"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// SummarizeType computes completion counts for every non-trashed wiki of a
+// type in a single aggregation. Each translation is reduced to the set of
+// template element numbers it fills; a $facet then groups those sets by
+// language and by element number, next to the public/private split.
+func (r *wikiRepositoryMongo) SummarizeType(ctx context.Context, typeParam string, elementNumbers []int) (*entity.TypeSummary, error) {
+	if elementNumbers == nil {
+		elementNumbers = []int{}
+	}
+
+	filledTranslations := mongo.Pipeline{
+		{{Key: "$unwind", Value: "$translation"}},
+		{{Key: "$match", Value: bson.M{"translation.language": bson.M{"$ne": nil}}}},
+		{{Key: "$project", Value: bson.M{
+			"language": "$translation.language",
+			"filled":   filledNumbersExpr(elementNumbers),
+		}}},
+	}
+
+	byLanguage := append(append(mongo.Pipeline{}, filledTranslations...),
+		bson.D{{Key: "$group", Value: bson.M{
+			"_id":   "$language",
+			"wikis": bson.M{"$sum": 1},
+			"started": bson.M{"$sum": bson.M{
+				"$cond": bson.A{bson.M{"$gt": bson.A{bson.M{"$size": "$filled"}, 0}}, 1, 0},
+			}},
+			"complete": bson.M{"$sum": bson.M{
+				"$cond": bson.A{bson.M{"$eq": bson.A{bson.M{"$size": "$filled"}, len(elementNumbers)}}, 1, 0},
+			}},
+			"elements_filled": bson.M{"$sum": bson.M{"$size": "$filled"}},
+		}}},
+		bson.D{{Key: "$project", Value: bson.M{
+			"_id":             0,
+			"language":        "$_id",
+			"wikis":           1,
+			"started":         1,
+			"complete":        1,
+			"elements_filled": 1,
+		}}},
+		bson.D{{Key: "$sort", Value: bson.M{"language": 1}}},
+	)
+
+	byElement := append(append(mongo.Pipeline{}, filledTranslations...),
+		bson.D{{Key: "$unwind", Value: "$filled"}},
+		bson.D{{Key: "$group", Value: bson.M{
+			"_id":    bson.M{"number": "$filled", "language": "$language"},
+			"filled": bson.M{"$sum": 1},
+		}}},
+		bson.D{{Key: "$project", Value: bson.M{
+			"_id":      0,
+			"number":   "$_id.number",
+			"language": "$_id.language",
+			"filled":   1,
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "number", Value: 1}, {Key: "language", Value: 1}}}},
+	)
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: notTrashed(bson.M{"type": typeParam})}},
+		{{Key: "$facet", Value: bson.M{
+			"visibility": bson.A{
+				bson.M{"$group": bson.M{
+					"_id":         nil,
+					"total_wikis": bson.M{"$sum": 1},
+					"public": bson.M{"$sum": bson.M{
+						"$cond": bson.A{bson.M{"$eq": bson.A{"$public", 1}}, 1, 0},
+					}},
+				}},
+			},
+			"languages": byLanguage,
+			"elements":  byElement,
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, mapMongoError(err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var results []struct {
+		Visibility []struct {
+			TotalWikis int `bson:"total_wikis"`
+			Public     int `bson:"public"`
+		} `bson:"visibility"`
+		Languages []entity.LanguageSummary    `bson:"languages"`
+		Elements  []entity.ElementFillSummary `bson:"elements"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, mapMongoError(err)
+	}
+
+	summary := &entity.TypeSummary{}
+	if len(results) == 0 {
+		return summary, nil
+	}
+
+	result := results[0]
+	if len(result.Visibility) > 0 {
+		summary.TotalWikis = result.Visibility[0].TotalWikis
+		summary.Public = result.Visibility[0].Public
+		summary.Private = summary.TotalWikis - summary.Public
+	}
+	summary.Languages = result.Languages
+	summary.Elements = result.Elements
+
+	return summary, nil
+}
+
+// filledNumbersExpr reduces $translation.elements to the distinct template
+// element numbers that carry a value, a payload, a picture or a video, as
+// entity.Element.HasContent does.
+func filledNumbersExpr(elementNumbers []int) bson.M {
+	nonBlank := func(field string) bson.M {
+		return bson.M{"$gt": bson.A{
+			bson.M{"$strLenCP": bson.M{"$trim": bson.M{"input": bson.M{"$ifNull": bson.A{field, ""}}}}},
+			0,
+		}}
+	}
+	present := func(field string) bson.M {
+		return bson.M{"$not": bson.A{bson.M{"$in": bson.A{bson.M{"$type": field}, bson.A{"missing", "null"}}}}}
+	}
+
+	filled := bson.M{"$filter": bson.M{
+		"input": bson.M{"$ifNull": bson.A{"$translation.elements", bson.A{}}},
+		"as":    "e",
+		"cond": bson.M{"$and": bson.A{
+			bson.M{"$in": bson.A{"$$e.number", elementNumbers}},
+			bson.M{"$or": bson.A{
+				nonBlank("$$e.value"),
+				present("$$e.title"),
+				present("$$e.button"),
+				present("$$e.link"),
+				present("$$e.media"),
+				bson.M{"$gt": bson.A{bson.M{"$size": bson.M{"$ifNull": bson.A{"$$e.picture_keys", bson.A{}}}}, 0}},
+				nonBlank("$$e.video_id"),
+			}},
+		}},
+	}}
+
+	return bson.M{"$setUnion": bson.A{
+		bson.M{"$map": bson.M{"input": filled, "as": "e", "in": "$$e.number"}},
+	}}
+}
@@ -0,0 +1,8 @@
+package request
+
+type BatchWikisRequest struct {
+	Type  string   `json:"type"`
+	Codes []string `json:"codes"`
+	// Language falls back to the X-App-Language header when omitted.
+	Language *int `json:"language"`
+}
@@ -0,0 +1,10 @@
+package request
+
+import "time"
+
+type CompletionTrendsRequest struct {
+	Type     string
+	Language *int
+	From     *time.Time
+	To       *time.Time
+}
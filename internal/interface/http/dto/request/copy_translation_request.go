@@ -0,0 +1,9 @@
+package request
+
+type CopyTranslationRequest struct {
+	// IncludeText also copies titles, keywords and text values; otherwise
+	// only structure and media keys are cloned.
+	IncludeText bool `json:"include_text"`
+	// Overwrite replaces the working copy of an existing target translation.
+	Overwrite bool `json:"overwrite"`
+}
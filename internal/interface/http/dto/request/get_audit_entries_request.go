@@ -0,0 +1,16 @@
+package request
+
+import "time"
+
+type GetAuditEntriesRequest struct {
+	WikiID   string
+	Type     string
+	Code     string
+	UserID   string
+	Action   string
+	Language *int
+	From     *time.Time
+	To       *time.Time
+	Page     int
+	Limit    int
+}
@@ -0,0 +1,10 @@
+package request
+
+type GetStatisticsRequest struct {
+	Type         string
+	Search       string
+	Page         int
+	Limit        int
+	Cursor       string
+	IncludeTotal bool
+}
@@ -0,0 +1,25 @@
+package request
+
+import "time"
+
+type GetWikisRequest struct {
+	Type            string
+	Search          string
+	Language        *int
+	Public          *int
+	Level           *int
+	Unit            string
+	WithLanguage    *int
+	WithoutLanguage *int
+	CreatedBy       string
+	UpdatedFrom     *time.Time
+	UpdatedTo       *time.Time
+	Sort            string
+	Fields          []string
+	View            string
+	Draft           bool
+	Page            int
+	Limit           int
+	Cursor          string
+	IncludeTotal    bool
+}
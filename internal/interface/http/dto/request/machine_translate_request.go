@@ -0,0 +1,6 @@
+package request
+
+type MachineTranslateRequest struct {
+	// Overwrite replaces the working copy of an existing target translation.
+	Overwrite bool `json:"overwrite"`
+}
@@ -0,0 +1,10 @@
+package request
+
+type SubmitReviewRequest struct {
+	ReviewerIDs []string `json:"reviewer_ids"`
+	Note        string   `json:"note"`
+}
+
+type ReviewDecisionRequest struct {
+	Comment string `json:"comment"`
+}
@@ -0,0 +1,6 @@
+package request
+
+type SaveLanguageFallbackRequest struct {
+	Languages      []int `json:"languages"`
+	FirstAvailable bool  `json:"first_available"`
+}
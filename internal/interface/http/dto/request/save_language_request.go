@@ -0,0 +1,8 @@
+package request
+
+type SaveLanguageRequest struct {
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+	Direction string `json:"direction"`
+	Enabled   *bool  `json:"enabled"`
+}
@@ -0,0 +1,11 @@
+package request
+
+import "time"
+
+// ScheduleWikiRequest sets publish/unpublish times on the whole wiki, or on a
+// single translation when Language is given. A null time clears it.
+type ScheduleWikiRequest struct {
+	Language    *int       `json:"language"`
+	PublishAt   *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
+}
@@ -0,0 +1,8 @@
+package request
+
+type SuggestWikisRequest struct {
+	Type     string
+	Query    string
+	Language int
+	Limit    int
+}
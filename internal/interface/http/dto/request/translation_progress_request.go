@@ -0,0 +1,10 @@
+package request
+
+type TranslationProgressRequest struct {
+	Type     string
+	Language *int
+	Level    *int
+	Unit     string
+	Page     int
+	Limit    int
+}
@@ -0,0 +1,27 @@
+package response
+
+import "time"
+
+type ReviewResponse struct {
+	ID          string             `json:"id"`
+	WikiID      string             `json:"wiki_id"`
+	WikiType    string             `json:"wiki_type"`
+	WikiCode    string             `json:"wiki_code"`
+	Language    int                `json:"language"`
+	Status      string             `json:"status"`
+	Note        string             `json:"note"`
+	SubmittedBy string             `json:"submitted_by"`
+	Reviewers   []ReviewerResponse `json:"reviewers"`
+	ResolvedAt  *time.Time         `json:"resolved_at,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+type ReviewerResponse struct {
+	UserID    string     `json:"user_id"`
+	Name      string     `json:"name"`
+	Email     string     `json:"email"`
+	Decision  string     `json:"decision"`
+	Comment   string     `json:"comment"`
+	DecidedAt *time.Time `json:"decided_at,omitempty"`
+}
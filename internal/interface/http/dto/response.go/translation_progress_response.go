@@ -0,0 +1,35 @@
+package response
+
+type TranslationProgressResponse struct {
+	Type         string                     `json:"type"`
+	TotalWikis   int                        `json:"total_wikis"`
+	ElementCount int                        `json:"element_count"`
+	Languages    []LanguageProgressResponse `json:"languages"`
+	Missing      MissingElementsPage        `json:"missing"`
+}
+
+type LanguageProgressResponse struct {
+	Language              int     `json:"language"`
+	Code                  string  `json:"code"`
+	WikisStarted          int     `json:"wikis_started"`
+	WikisComplete         int     `json:"wikis_complete"`
+	WikisCompletePercent  float64 `json:"wikis_complete_percent"`
+	ElementsFilled        int     `json:"elements_filled"`
+	ElementsTotal         int     `json:"elements_total"`
+	ElementsFilledPercent float64 `json:"elements_filled_percent"`
+}
+
+type MissingElementsPage struct {
+	Items      []MissingElementsResponse `json:"items"`
+	Page       int                       `json:"page"`
+	Limit      int                       `json:"limit"`
+	Total      int                       `json:"total"`
+	TotalPages int                       `json:"total_pages"`
+}
+
+// MissingElementsResponse lists, per language ID, the template element
+// numbers a wiki has not filled in yet.
+type MissingElementsResponse struct {
+	Code      string           `json:"code"`
+	Languages map[string][]int `json:"languages"`
+}
@@ -1,6 +1,7 @@
 package response
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -16,9 +17,97 @@ type WikiResponse struct {
 	Public        int                   `json:"public"`
 	Translation   []TranslationResponse `json:"translation"`
 	ImageWiki     string                `json:"image_wiki"`
+	PublishAt     *time.Time            `json:"publish_at,omitempty"`
+	UnpublishAt   *time.Time            `json:"unpublish_at,omitempty"`
+	CreatedBy     string                `json:"created_by"`
 	CreatedByUser *CreatedByUserInfo    `json:"creator,omitempty"`
+	UpdatedBy     string                `json:"updated_by,omitempty"`
+	UpdatedByUser *CreatedByUserInfo    `json:"updater,omitempty"`
 	CreatedAt     time.Time             `json:"created_at"`
 	UpdatedAt     time.Time             `json:"updated_at"`
+	DeletedAt     *time.Time            `json:"deleted_at,omitempty"`
+	DeletedBy     string                `json:"deleted_by,omitempty"`
+
+	LanguageResolution *LanguageResolutionResponse `json:"language_resolution,omitempty"`
+	Highlights         []HighlightResponse         `json:"highlights,omitempty"`
+
+	selection *FieldSelection
+}
+
+// HighlightResponse shows where a search matched: a snippet of a title,
+// keywords or an element's text, with the matches as rune offsets into the
+// snippet.
+type HighlightResponse struct {
+	Language int                  `json:"language"`
+	Field    string               `json:"field"`
+	Element  *int                 `json:"element,omitempty"`
+	Snippet  string               `json:"snippet"`
+	Matches  []MatchRangeResponse `json:"matches"`
+}
+
+type MatchRangeResponse struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// FieldSelection lists the JSON keys a sparse wiki response keeps, at the
+// wiki level and within each translation.
+type FieldSelection struct {
+	Wiki        map[string]bool
+	Translation map[string]bool
+}
+
+// Select limits the JSON output of r to selection; nil restores the full
+// response.
+func (r *WikiResponse) Select(selection *FieldSelection) {
+	r.selection = selection
+}
+
+// MarshalJSON drops the keys outside the selection, if one is set.
+func (r WikiResponse) MarshalJSON() ([]byte, error) {
+	type plain WikiResponse
+	data, err := json.Marshal(plain(r))
+	if err != nil || r.selection == nil {
+		return data, err
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+	for key := range object {
+		if !r.selection.Wiki[key] {
+			delete(object, key)
+		}
+	}
+
+	if raw, ok := object["translation"]; ok {
+		var translations []map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &translations); err != nil {
+			return nil, err
+		}
+		for _, translation := range translations {
+			for key := range translation {
+				if !r.selection.Translation[key] {
+					delete(translation, key)
+				}
+			}
+		}
+		if object["translation"], err = json.Marshal(translations); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(object)
+}
+
+// LanguageResolutionResponse tells a reader which language was served for
+// the language it asked for. Served is nil when no translation was found
+// and the template elements were returned instead.
+type LanguageResolutionResponse struct {
+	Requested    int  `json:"requested"`
+	Served       *int `json:"served"`
+	FallbackUsed bool `json:"fallback_used"`
 }
 
 type CreatedByUserInfo struct {
@@ -30,13 +119,35 @@ type CreatedByUserInfo struct {
 	Avatar   string `json:"avatar"`
 }
 
+type ContributorResponse struct {
+	UserID      string             `json:"user_id"`
+	User        *CreatedByUserInfo `json:"user,omitempty"`
+	EditCount   int                `json:"edit_count"`
+	FirstEditAt time.Time          `json:"first_edit_at"`
+	LastEditAt  time.Time          `json:"last_edit_at"`
+}
+
 type TranslationResponse struct {
-	Language *int              `json:"language"`
-	Title    *string           `json:"title"`
-	Keywords *string           `json:"keywords"`
-	Level    *int              `json:"level"`
-	Unit     *string           `json:"unit"`
-	Elements []ElementResponse `json:"elements"`
+	Language    *int              `json:"language"`
+	Title       *string           `json:"title"`
+	Keywords    *string           `json:"keywords"`
+	Level       *int              `json:"level"`
+	Unit        *string           `json:"unit"`
+	Elements    []ElementResponse `json:"elements"`
+	Status      string            `json:"status,omitempty"`
+	PublishedAt *time.Time        `json:"published_at,omitempty"`
+	PublishedBy string            `json:"published_by,omitempty"`
+	PublishAt   *time.Time        `json:"publish_at,omitempty"`
+	UnpublishAt *time.Time        `json:"unpublish_at,omitempty"`
+
+	MachineTranslated *MachineTranslationResponse `json:"machine_translated,omitempty"`
+}
+
+type MachineTranslationResponse struct {
+	Provider       string    `json:"provider"`
+	SourceLanguage int       `json:"source_language"`
+	TranslatedAt   time.Time `json:"translated_at"`
+	TranslatedBy   string    `json:"translated_by"`
 }
 
 type PictureKeyUrl struct {
@@ -81,3 +192,20 @@ type TitleResponse struct {
 	ImageKey string `json:"image_key"`
 	ImageUrl string `json:"image_url"`
 }
+
+type CopyTranslationsResponse struct {
+	Copied  int                   `json:"copied"`
+	Skipped []CopySkippedResponse `json:"skipped"`
+}
+
+type CopySkippedResponse struct {
+	Code   string `json:"code"`
+	Reason string `json:"reason"`
+}
+
+// BatchWikisResponse lists the wikis found in the order their codes were
+// requested, and the codes that matched no wiki.
+type BatchWikisResponse struct {
+	Wikis   []*WikiResponse `json:"wikis"`
+	Missing []string        `json:"missing"`
+}
@@ -15,11 +15,16 @@ type ElementStatistics struct {
 	Check  string `json:"check"` // "yes" or "no"
 }
 
+// PaginationResponse describes a page of a listing. Total and TotalPages
+// are only set when the total was requested. The cursors are opaque and
+// empty when there is nothing further in that direction.
 type PaginationResponse struct {
-	Page       int `json:"page"`
-	Limit      int `json:"limit"`
-	Total      int `json:"total"`
-	TotalPages int `json:"total_pages"`
+	Page       int    `json:"page"`
+	Limit      int    `json:"limit"`
+	Total      *int   `json:"total,omitempty"`
+	TotalPages *int   `json:"total_pages,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
 }
 
 type WikiTemplateResponse struct {
@@ -34,3 +39,64 @@ type WikiTemplateResponse struct {
 	CreatedAt      time.Time         `json:"created_at"`
 	UpdatedAt      time.Time         `json:"updated_at"`
 }
+
+type TypeSummaryResponse struct {
+	Type         string                    `json:"type"`
+	TotalWikis   int                       `json:"total_wikis"`
+	Public       int                       `json:"public"`
+	Private      int                       `json:"private"`
+	ElementCount int                       `json:"element_count"`
+	Languages    []LanguageSummaryResponse `json:"languages"`
+	Elements     []ElementSummaryResponse  `json:"elements"`
+}
+
+type LanguageSummaryResponse struct {
+	Language              int     `json:"language"`
+	Code                  string  `json:"code"`
+	Wikis                 int     `json:"wikis"`
+	Started               int     `json:"started"`
+	Complete              int     `json:"complete"`
+	CompletePercent       float64 `json:"complete_percent"`
+	ElementsFilled        int     `json:"elements_filled"`
+	ElementsFilledPercent float64 `json:"elements_filled_percent"`
+}
+
+type ElementSummaryResponse struct {
+	Number    int                                       `json:"number"`
+	Type      string                                    `json:"type"`
+	Languages map[string]ElementLanguageSummaryResponse `json:"languages"` // keyed by language ID
+}
+
+type ElementLanguageSummaryResponse struct {
+	Filled  int     `json:"filled"`
+	Percent float64 `json:"percent"`
+}
+
+type CompletionTrendsResponse struct {
+	Type   string                     `json:"type"`
+	From   time.Time                  `json:"from"`
+	To     time.Time                  `json:"to"`
+	Series []CompletionSeriesResponse `json:"series"`
+}
+
+// CompletionSeriesResponse is one language's daily history. The change
+// fields compare the last point with the first, so a language that stalled
+// over the range shows zero.
+type CompletionSeriesResponse struct {
+	Language                    int                       `json:"language"`
+	Code                        string                    `json:"code"`
+	WikisCompletePercentChange  float64                   `json:"wikis_complete_percent_change"`
+	ElementsFilledPercentChange float64                   `json:"elements_filled_percent_change"`
+	Points                      []CompletionPointResponse `json:"points"`
+}
+
+type CompletionPointResponse struct {
+	Date                  time.Time `json:"date"`
+	TotalWikis            int       `json:"total_wikis"`
+	WikisStarted          int       `json:"wikis_started"`
+	WikisComplete         int       `json:"wikis_complete"`
+	WikisCompletePercent  float64   `json:"wikis_complete_percent"`
+	ElementsFilled        int       `json:"elements_filled"`
+	ElementsTotal         int       `json:"elements_total"`
+	ElementsFilledPercent float64   `json:"elements_filled_percent"`
+}
@@ -0,0 +1,8 @@
+package response
+
+type WikiSuggestionResponse struct {
+	ID       string `json:"id"`
+	Code     string `json:"code"`
+	Language int    `json:"language"`
+	Title    string `json:"title"`
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"time"
+	"wiki-service/internal/domain/usecase"
+	"wiki-service/internal/interface/http/dto/request"
+	libs_constant "wiki-service/pkg/libs/constant"
+	libs_helper "wiki-service/pkg/libs/helper"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AuditHandler struct {
+	auditUseCase usecase.AuditUseCase
+}
+
+func NewAuditHandler(auditUseCase usecase.AuditUseCase) *AuditHandler {
+	return &AuditHandler{
+		auditUseCase: auditUseCase,
+	}
+}
+
+func (h *AuditHandler) GetAuditEntries(c *fiber.Ctx) error {
+	pageParam := c.Query("page", "1")
+	page, err := strconv.Atoi(pageParam)
+	if err != nil || page < 1 {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidPage)
+		return nil
+	}
+
+	limitParam := c.Query("limit", "20")
+	limit, err := strconv.Atoi(limitParam)
+	if err != nil || limit < 1 {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLimit)
+		return nil
+	}
+
+	req := request.GetAuditEntriesRequest{
+		WikiID: c.Query("wiki_id"),
+		Type:   c.Query("type"),
+		Code:   c.Query("code"),
+		UserID: c.Query("user_id"),
+		Action: c.Query("action"),
+		Page:   page,
+		Limit:  limit,
+	}
+
+	if langParam := c.Query("language"); langParam != "" {
+		lang, err := strconv.Atoi(langParam)
+		if err != nil || lang < 0 {
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLanguage)
+			return nil
+		}
+		req.Language = &lang
+	}
+
+	if fromParam := c.Query("from"); fromParam != "" {
+		from, err := time.Parse(time.RFC3339, fromParam)
+		if err != nil {
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidFrom)
+			return nil
+		}
+		req.From = &from
+	}
+
+	if toParam := c.Query("to"); toParam != "" {
+		to, err := time.Parse(time.RFC3339, toParam)
+		if err != nil {
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidTo)
+			return nil
+		}
+		req.To = &to
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	entries, total, err := h.auditUseCase.GetAuditEntries(ctx, req)
+	if err != nil {
+		return err
+	}
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	response := fiber.Map{
+		"items":       entries,
+		"page":        page,
+		"limit":       limit,
+		"total":       total,
+		"total_pages": totalPages,
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Audit entries fetched successfully", response)
+}
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"wiki-service/internal/domain/usecase"
+	"wiki-service/internal/interface/http/dto/request"
+	libs_constant "wiki-service/pkg/libs/constant"
+	libs_helper "wiki-service/pkg/libs/helper"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type LanguageHandler struct {
+	languageUseCase usecase.LanguageUseCase
+}
+
+func NewLanguageHandler(languageUseCase usecase.LanguageUseCase) *LanguageHandler {
+	return &LanguageHandler{
+		languageUseCase: languageUseCase,
+	}
+}
+
+func (h *LanguageHandler) GetLanguages(c *fiber.Ctx) error {
+	includeDisabled := c.QueryBool("include_disabled", false)
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	languages, err := h.languageUseCase.GetLanguages(ctx, includeDisabled)
+	if err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Languages fetched successfully", languages)
+}
+
+func (h *LanguageHandler) SaveLanguage(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingID)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	var req request.SaveLanguageRequest
+	if err := c.BodyParser(&req); err != nil {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, err, libs_helper.ErrInvalidRequest)
+		return nil
+	}
+
+	language, err := h.languageUseCase.SaveLanguage(ctx, id, req)
+	if err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Language saved successfully", language)
+}
+
+func (h *LanguageHandler) GetFallbacks(c *fiber.Ctx) error {
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	fallbacks, err := h.languageUseCase.GetFallbacks(ctx)
+	if err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Language fallbacks fetched successfully", fallbacks)
+}
+
+func (h *LanguageHandler) SaveFallback(c *fiber.Ctx) error {
+	userID, exists := c.Locals("user_id").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingUserID)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	var req request.SaveLanguageFallbackRequest
+	if err := c.BodyParser(&req); err != nil {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, err, libs_helper.ErrInvalidRequest)
+		return nil
+	}
+
+	fallback, err := h.languageUseCase.SaveFallback(ctx, c.Params("scope"), c.Params("scopeId"), req, userID)
+	if err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Language fallback saved successfully", fallback)
+}
+
+func (h *LanguageHandler) DeleteFallback(c *fiber.Ctx) error {
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	if err := h.languageUseCase.DeleteFallback(ctx, c.Params("scope"), c.Params("scopeId")); err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Language fallback deleted successfully", nil)
+}
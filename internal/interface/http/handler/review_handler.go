@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"wiki-service/internal/domain/usecase"
+	"wiki-service/internal/interface/http/dto/request"
+	libs_constant "wiki-service/pkg/libs/constant"
+	libs_helper "wiki-service/pkg/libs/helper"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ReviewHandler struct {
+	reviewUseCase usecase.ReviewUseCase
+}
+
+func NewReviewHandler(reviewUseCase usecase.ReviewUseCase) *ReviewHandler {
+	return &ReviewHandler{
+		reviewUseCase: reviewUseCase,
+	}
+}
+
+func (h *ReviewHandler) SubmitReview(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingID)
+		return nil
+	}
+
+	language, err := strconv.Atoi(c.Params("lang"))
+	if err != nil || language < 0 {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLanguage)
+		return nil
+	}
+
+	var req request.SubmitReviewRequest
+	if err := c.BodyParser(&req); err != nil {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, err, libs_helper.ErrInvalidRequest)
+		return nil
+	}
+
+	userID, exists := c.Locals("user_id").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingUserID)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	review, err := h.reviewUseCase.SubmitReview(ctx, id, language, req, userID)
+	if err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusCreated, "Review submitted successfully", review)
+}
+
+func (h *ReviewHandler) GetReviewQueue(c *fiber.Ctx) error {
+	pageParam := c.Query("page", "1")
+	page, err := strconv.Atoi(pageParam)
+	if err != nil || page < 1 {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidPage)
+		return nil
+	}
+
+	limitParam := c.Query("limit", "20")
+	limit, err := strconv.Atoi(limitParam)
+	if err != nil || limit < 1 {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLimit)
+		return nil
+	}
+
+	userID, exists := c.Locals("user_id").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingUserID)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	reviews, total, err := h.reviewUseCase.GetReviewQueue(ctx, userID, page, limit)
+	if err != nil {
+		return err
+	}
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	response := fiber.Map{
+		"items":       reviews,
+		"page":        page,
+		"limit":       limit,
+		"total":       total,
+		"total_pages": totalPages,
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Review queue fetched successfully", response)
+}
+
+func (h *ReviewHandler) GetReview(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingID)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	review, err := h.reviewUseCase.GetReview(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Review fetched successfully", review)
+}
+
+func (h *ReviewHandler) ApproveReview(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingID)
+		return nil
+	}
+
+	var req request.ReviewDecisionRequest
+	if err := c.BodyParser(&req); err != nil {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, err, libs_helper.ErrInvalidRequest)
+		return nil
+	}
+
+	userID, exists := c.Locals("user_id").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingUserID)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	review, err := h.reviewUseCase.ApproveReview(ctx, id, req, userID)
+	if err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Review approved successfully", review)
+}
+
+func (h *ReviewHandler) RejectReview(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingID)
+		return nil
+	}
+
+	var req request.ReviewDecisionRequest
+	if err := c.BodyParser(&req); err != nil {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, err, libs_helper.ErrInvalidRequest)
+		return nil
+	}
+
+	userID, exists := c.Locals("user_id").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingUserID)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	review, err := h.reviewUseCase.RejectReview(ctx, id, req, userID)
+	if err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Review rejected successfully", review)
+}
@@ -1,12 +1,19 @@
 package handler
 
 import (
+	"bufio"
 	"context"
+	"fmt"
+	"log"
 	"strconv"
+	"strings"
+	"time"
 	"wiki-service/internal/domain/usecase"
 	"wiki-service/internal/interface/http/dto/request"
+	user_gateway_dto "wiki-service/pkg/gateway/dto/user"
 	libs_constant "wiki-service/pkg/libs/constant"
 	libs_helper "wiki-service/pkg/libs/helper"
+	"wiki-service/pkg/spreadsheet"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -30,13 +37,13 @@ func (h *WikiHandler) CreateWikiTemplate(c *fiber.Ctx) error {
 
 	userID, exists := c.Locals("user_id").(string)
 	if !exists {
-		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, "Missing userID")
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingUserID)
 		return nil
 	}
 
 	token, exists := c.Locals("token").(string)
 	if !exists {
-		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, "Missing token")
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
 		return nil
 	}
 
@@ -44,8 +51,7 @@ func (h *WikiHandler) CreateWikiTemplate(c *fiber.Ctx) error {
 
 	err := h.wikiUseCase.CreateWikiTemplate(ctx, req, userID)
 	if err != nil {
-		_ = libs_helper.SendError(c, fiber.StatusInternalServerError, err, libs_helper.ErrInternal)
-		return nil
+		return err
 	}
 
 	return libs_helper.SendSuccess(c, fiber.StatusCreated, "Wiki template created successfully", nil)
@@ -54,13 +60,13 @@ func (h *WikiHandler) CreateWikiTemplate(c *fiber.Ctx) error {
 func (h *WikiHandler) GetTemplate(c *fiber.Ctx) error {
 	typeParam := c.Query("type")
 	if typeParam == "" {
-		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, "Missing type parameter")
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingType)
 		return nil
 	}
 
 	token, exists := c.Locals("token").(string)
 	if !exists {
-		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, "Missing token")
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
 		return nil
 	}
 
@@ -68,8 +74,7 @@ func (h *WikiHandler) GetTemplate(c *fiber.Ctx) error {
 
 	templates, err := h.wikiUseCase.GetTemplate(ctx, typeParam)
 	if err != nil {
-		_ = libs_helper.SendError(c, fiber.StatusInternalServerError, err, libs_helper.ErrInternal)
-		return nil
+		return err
 	}
 
 	return libs_helper.SendSuccess(c, fiber.StatusOK, "Templates fetched successfully", templates)
@@ -79,41 +84,251 @@ func (h *WikiHandler) GetStatistics(c *fiber.Ctx) error {
 	pageParam := c.Query("page", "1")
 	page, err := strconv.Atoi(pageParam)
 	if err != nil || page < 1 {
-		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, "Invalid page parameter")
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidPage)
 		return nil
 	}
 
 	limitParam := c.Query("limit", "20")
 	limit, err := strconv.Atoi(limitParam)
 	if err != nil || limit < 1 {
-		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, "Invalid limit parameter")
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLimit)
 		return nil
 	}
 
-	typeParam := c.Query("type", "")
-	searchParam := c.Query("search")
+	req := request.GetStatisticsRequest{
+		Type:         c.Query("type", ""),
+		Search:       c.Query("search"),
+		Page:         page,
+		Limit:        limit,
+		Cursor:       c.Query("cursor"),
+		IncludeTotal: c.QueryBool("include_total", true),
+	}
 
 	token, exists := c.Locals("token").(string)
 	if !exists {
-		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, "Missing token")
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
 		return nil
 	}
 
 	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
 
-	statistics, err := h.wikiUseCase.GetStatistics(ctx, page, limit, typeParam, searchParam)
+	statistics, err := h.wikiUseCase.GetStatistics(ctx, req)
 	if err != nil {
-		_ = libs_helper.SendError(c, fiber.StatusInternalServerError, err, libs_helper.ErrInternal)
-		return nil
+		return err
 	}
 
 	return libs_helper.SendSuccess(c, fiber.StatusOK, "Statistics fetched successfully", statistics)
 }
 
+func (h *WikiHandler) GetTranslationProgress(c *fiber.Ctx) error {
+	pageParam := c.Query("page", "1")
+	page, err := strconv.Atoi(pageParam)
+	if err != nil || page < 1 {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidPage)
+		return nil
+	}
+
+	limitParam := c.Query("limit", "50")
+	limit, err := strconv.Atoi(limitParam)
+	if err != nil || limit < 1 {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLimit)
+		return nil
+	}
+
+	typeParam := c.Query("type")
+	if typeParam == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingType)
+		return nil
+	}
+
+	req := request.TranslationProgressRequest{
+		Type:  typeParam,
+		Unit:  c.Query("unit"),
+		Page:  page,
+		Limit: limit,
+	}
+
+	if langParam := c.Query("language"); langParam != "" {
+		lang, err := strconv.Atoi(langParam)
+		if err != nil || lang < 0 {
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLanguage)
+			return nil
+		}
+		req.Language = &lang
+	}
+
+	if levelParam := c.Query("level"); levelParam != "" {
+		level, err := strconv.Atoi(levelParam)
+		if err != nil {
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLevel)
+			return nil
+		}
+		req.Level = &level
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	progress, err := h.wikiUseCase.GetTranslationProgress(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Translation progress fetched successfully", progress)
+}
+
+func (h *WikiHandler) GetTypeSummary(c *fiber.Ctx) error {
+	typeParam := c.Query("type")
+	if typeParam == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingType)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	summary, err := h.wikiUseCase.GetTypeSummary(ctx, typeParam)
+	if err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Statistics summary fetched successfully", summary)
+}
+
+// statisticsExportTimeout bounds how long an export may keep its cursor
+// open once the response has started streaming.
+const statisticsExportTimeout = 10 * time.Minute
+
+func (h *WikiHandler) ExportStatistics(c *fiber.Ctx) error {
+	format := c.Query("format", spreadsheet.FormatCSV)
+	if !spreadsheet.IsSupported(format) {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidFormat)
+		return nil
+	}
+
+	typeParam := c.Query("type")
+	if typeParam == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingType)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	export, err := h.wikiUseCase.ExportStatistics(ctx, typeParam)
+	if err != nil {
+		return err
+	}
+
+	c.Set(fiber.HeaderContentType, spreadsheet.ContentType(format))
+	c.Attachment(fmt.Sprintf("statistics_%s.%s", export.Type, format))
+
+	// The request context is recycled once the handler returns, so the
+	// stream runs on its own bounded context. Headers are already sent by
+	// then; failures can only be logged and end the file early.
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		streamCtx, cancel := context.WithTimeout(context.Background(), statisticsExportTimeout)
+		defer cancel()
+
+		sheet, err := spreadsheet.New(format, w)
+		if err != nil {
+			log.Printf("failed to start statistics export %s: %v", export.Type, err)
+			return
+		}
+
+		err = sheet.WriteRow(export.Header)
+		if err == nil {
+			err = export.Rows(streamCtx, func(row []string) error {
+				if err := sheet.WriteRow(row); err != nil {
+					return err
+				}
+				return w.Flush()
+			})
+		}
+		if err != nil {
+			log.Printf("failed to stream statistics export %s: %v", export.Type, err)
+		}
+
+		if err := sheet.Close(); err != nil {
+			log.Printf("failed to finish statistics export %s: %v", export.Type, err)
+		}
+		_ = w.Flush()
+	})
+
+	return nil
+}
+
+func (h *WikiHandler) GetCompletionTrends(c *fiber.Ctx) error {
+	typeParam := c.Query("type")
+	if typeParam == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingType)
+		return nil
+	}
+
+	req := request.CompletionTrendsRequest{Type: typeParam}
+
+	if langParam := c.Query("language"); langParam != "" {
+		lang, err := strconv.Atoi(langParam)
+		if err != nil || lang < 0 {
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLanguage)
+			return nil
+		}
+		req.Language = &lang
+	}
+
+	if fromParam := c.Query("from"); fromParam != "" {
+		from, err := time.Parse(time.RFC3339, fromParam)
+		if err != nil {
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidFrom)
+			return nil
+		}
+		req.From = &from
+	}
+
+	if toParam := c.Query("to"); toParam != "" {
+		to, err := time.Parse(time.RFC3339, toParam)
+		if err != nil {
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidTo)
+			return nil
+		}
+		req.To = &to
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	trends, err := h.wikiUseCase.GetCompletionTrends(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Completion trends fetched successfully", trends)
+}
+
 func (h *WikiHandler) GetWikiByCode(c *fiber.Ctx) error {
 	code := c.Query("code")
 	if code == "" {
-		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, "Missing code")
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingCode)
 		return nil
 	}
 
@@ -121,84 +336,255 @@ func (h *WikiHandler) GetWikiByCode(c *fiber.Ctx) error {
 
 	typeParam := c.Query("type")
 	if typeParam == "" {
-		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, "Missing type parameter")
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingType)
 		return nil
 	}
 
 	token, exists := c.Locals("token").(string)
 	if !exists {
-		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, "Missing token")
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	draft, ok := parsePreview(c)
+	if !ok {
+		_ = libs_helper.SendError(c, fiber.StatusForbidden, nil, libs_helper.ErrPreviewForbidden)
 		return nil
 	}
 
 	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+	ctx = withOrganization(ctx, c)
 
 	lang := int(language)
-	
-	wiki, err := h.wikiUseCase.GetWikiByCode(ctx, code, &lang, typeParam)
+
+	wiki, err := h.wikiUseCase.GetWikiByCode(ctx, code, &lang, typeParam, draft)
 	if err != nil {
-		_ = libs_helper.SendError(c, fiber.StatusInternalServerError, err, libs_helper.ErrInternal)
-		return nil
+		return err
 	}
 
 	return libs_helper.SendSuccess(c, fiber.StatusOK, "Wiki fetched successfully", wiki)
 }
 
-func (h *WikiHandler) GetWikis(c *fiber.Ctx) error {
-	pageParam := c.Query("page", "1")
-	page, err := strconv.Atoi(pageParam)
-	if err != nil || page < 1 {
-		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, "Invalid page parameter")
+// GetWikisByCodes fetches many wikis by code in one call. The language
+// comes from the body, falling back to X-App-Language.
+func (h *WikiHandler) GetWikisByCodes(c *fiber.Ctx) error {
+	var req request.BatchWikisRequest
+	if err := c.BodyParser(&req); err != nil {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, err, libs_helper.ErrInvalidRequest)
 		return nil
 	}
 
-	limitParam := c.Query("limit", "20")
-	limit, err := strconv.Atoi(limitParam)
+	if req.Type == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingType)
+		return nil
+	}
+
+	if req.Language == nil {
+		lang := int(libs_helper.ParseAppLanguage(c.Get("X-App-Language"), 1))
+		req.Language = &lang
+	} else if *req.Language < 0 {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLanguage)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	draft, ok := parsePreview(c)
+	if !ok {
+		_ = libs_helper.SendError(c, fiber.StatusForbidden, nil, libs_helper.ErrPreviewForbidden)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+	ctx = withOrganization(ctx, c)
+
+	result, err := h.wikiUseCase.GetWikisByCodes(ctx, req, draft)
+	if err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Wikis fetched successfully", result)
+}
+
+// SuggestWikis serves autocomplete. The language comes from the language
+// query parameter, falling back to X-App-Language.
+func (h *WikiHandler) SuggestWikis(c *fiber.Ctx) error {
+	typeParam := c.Query("type")
+	if typeParam == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingType)
+		return nil
+	}
+
+	query := c.Query("q")
+	if strings.TrimSpace(query) == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingQuery)
+		return nil
+	}
+
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
 	if err != nil || limit < 1 {
-		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, "Invalid limit parameter")
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLimit)
 		return nil
 	}
 
-	var language *int
+	language := int(libs_helper.ParseAppLanguage(c.Get("X-App-Language"), 1))
 	if langParam := c.Query("language"); langParam != "" {
 		lang, err := strconv.Atoi(langParam)
 		if err != nil || lang < 0 {
-			_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, "Invalid language parameter")
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLanguage)
 			return nil
 		}
-		language = &lang
-	} else {
-		language = nil
+		language = lang
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	suggestions, err := h.wikiUseCase.SuggestWikis(ctx, request.SuggestWikisRequest{
+		Type:     typeParam,
+		Query:    query,
+		Language: language,
+		Limit:    limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Suggestions fetched successfully", suggestions)
+}
+
+func (h *WikiHandler) GetWikis(c *fiber.Ctx) error {
+	pageParam := c.Query("page", "1")
+	page, err := strconv.Atoi(pageParam)
+	if err != nil || page < 1 {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidPage)
+		return nil
+	}
+
+	limitParam := c.Query("limit", "20")
+	limit, err := strconv.Atoi(limitParam)
+	if err != nil || limit < 1 {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLimit)
+		return nil
 	}
 
 	typeParam := c.Query("type")
 	if typeParam == "" {
-		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, "Missing type parameter")
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingType)
 		return nil
 	}
 
-	searchParam := c.Query("search")
+	req := request.GetWikisRequest{
+		Type:         typeParam,
+		Search:       c.Query("search"),
+		Unit:         c.Query("unit"),
+		CreatedBy:    c.Query("created_by"),
+		Sort:         c.Query("sort"),
+		View:         c.Query("view"),
+		Page:         page,
+		Limit:        limit,
+		Cursor:       c.Query("cursor"),
+		IncludeTotal: c.QueryBool("include_total", true),
+	}
+
+	if fieldsParam := c.Query("fields"); fieldsParam != "" {
+		req.Fields = strings.Split(fieldsParam, ",")
+	}
+
+	languageParams := []struct {
+		name   string
+		target **int
+	}{
+		{"language", &req.Language},
+		{"has_language", &req.WithLanguage},
+		{"missing_language", &req.WithoutLanguage},
+	}
+	for _, param := range languageParams {
+		if value := c.Query(param.name); value != "" {
+			lang, err := strconv.Atoi(value)
+			if err != nil || lang < 0 {
+				_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLanguage)
+				return nil
+			}
+			*param.target = &lang
+		}
+	}
+
+	if publicParam := c.Query("public"); publicParam != "" {
+		public, err := strconv.Atoi(publicParam)
+		if err != nil || (public != 0 && public != 1) {
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidPublic)
+			return nil
+		}
+		req.Public = &public
+	}
+
+	if levelParam := c.Query("level"); levelParam != "" {
+		level, err := strconv.Atoi(levelParam)
+		if err != nil {
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLevel)
+			return nil
+		}
+		req.Level = &level
+	}
+
+	if fromParam := c.Query("updated_from"); fromParam != "" {
+		from, err := time.Parse(time.RFC3339, fromParam)
+		if err != nil {
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidFrom)
+			return nil
+		}
+		req.UpdatedFrom = &from
+	}
+
+	if toParam := c.Query("updated_to"); toParam != "" {
+		to, err := time.Parse(time.RFC3339, toParam)
+		if err != nil {
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidTo)
+			return nil
+		}
+		req.UpdatedTo = &to
+	}
 
 	token, exists := c.Locals("token").(string)
 	if !exists {
-		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, "Missing token")
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
 		return nil
 	}
 
+	draft, ok := parsePreview(c)
+	if !ok {
+		_ = libs_helper.SendError(c, fiber.StatusForbidden, nil, libs_helper.ErrPreviewForbidden)
+		return nil
+	}
+	req.Draft = draft
+
 	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+	ctx = withOrganization(ctx, c)
 
-	wikiResponses, total, err := h.wikiUseCase.GetWikis(ctx, page, limit, language, typeParam, searchParam)
+	wikiResponses, pagination, err := h.wikiUseCase.GetWikis(ctx, req)
 	if err != nil {
-		_ = libs_helper.SendError(c, fiber.StatusInternalServerError, err, libs_helper.ErrInternal)
-		return nil
+		return err
 	}
-	totalPages := int((total + int64(limit) - 1) / int64(limit))
 	response := fiber.Map{
 		"items":       wikiResponses,
-		"page":        page,
-		"limit":       limit,
-		"total":       total,
-		"total_pages": totalPages,
+		"page":        pagination.Page,
+		"limit":       pagination.Limit,
+		"next_cursor": pagination.NextCursor,
+		"prev_cursor": pagination.PrevCursor,
+	}
+	if pagination.Total != nil {
+		response["total"] = *pagination.Total
+		response["total_pages"] = *pagination.TotalPages
 	}
 
 	return libs_helper.SendSuccess(c, fiber.StatusOK, "Wikis fetched successfully", response)
@@ -207,7 +593,7 @@ func (h *WikiHandler) GetWikis(c *fiber.Ctx) error {
 func (h *WikiHandler) GetWikiByID(c *fiber.Ctx) error {
 	id := c.Params("id")
 	if id == "" {
-		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, "Missing id")
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingID)
 		return nil
 	}
 
@@ -215,7 +601,7 @@ func (h *WikiHandler) GetWikiByID(c *fiber.Ctx) error {
 	if langParam := c.Query("language"); langParam != "" {
 		lang, err := strconv.Atoi(langParam)
 		if err != nil || lang < 0 {
-			_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, "Invalid language parameter")
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLanguage)
 			return nil
 		}
 		language = &lang
@@ -223,16 +609,22 @@ func (h *WikiHandler) GetWikiByID(c *fiber.Ctx) error {
 
 	token, exists := c.Locals("token").(string)
 	if !exists {
-		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, "Missing token")
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	draft, ok := parsePreview(c)
+	if !ok {
+		_ = libs_helper.SendError(c, fiber.StatusForbidden, nil, libs_helper.ErrPreviewForbidden)
 		return nil
 	}
 
 	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+	ctx = withOrganization(ctx, c)
 
-	wiki, err := h.wikiUseCase.GetWikiByID(ctx, id, language)
+	wiki, err := h.wikiUseCase.GetWikiByID(ctx, id, language, draft)
 	if err != nil {
-		_ = libs_helper.SendError(c, fiber.StatusInternalServerError, err, libs_helper.ErrInternal)
-		return nil
+		return err
 	}
 
 	return libs_helper.SendSuccess(c, fiber.StatusOK, "Wiki fetched successfully", wiki)
@@ -241,13 +633,19 @@ func (h *WikiHandler) GetWikiByID(c *fiber.Ctx) error {
 func (h *WikiHandler) UpdateWiki(c *fiber.Ctx) error {
 	id := c.Params("id")
 	if id == "" {
-		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, "Missing id")
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingID)
+		return nil
+	}
+
+	userID, exists := c.Locals("user_id").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingUserID)
 		return nil
 	}
 
 	token, exists := c.Locals("token").(string)
 	if !exists {
-		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, "Missing token")
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
 		return nil
 	}
 
@@ -259,11 +657,366 @@ func (h *WikiHandler) UpdateWiki(c *fiber.Ctx) error {
 		return nil
 	}
 
-	err := h.wikiUseCase.UpdateWiki(ctx, id, req)
+	err := h.wikiUseCase.UpdateWiki(ctx, id, req, userID)
 	if err != nil {
-		_ = libs_helper.SendError(c, fiber.StatusInternalServerError, err, libs_helper.ErrInternal)
-		return nil
+		return err
 	}
 
 	return libs_helper.SendSuccess(c, fiber.StatusOK, "Wiki updated successfully", nil)
 }
+
+func (h *WikiHandler) PublishTranslation(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingID)
+		return nil
+	}
+
+	language, err := strconv.Atoi(c.Params("lang"))
+	if err != nil || language < 0 {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLanguage)
+		return nil
+	}
+
+	userID, exists := c.Locals("user_id").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingUserID)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	if err := h.wikiUseCase.PublishTranslation(ctx, id, language, userID); err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Translation published successfully", nil)
+}
+
+func (h *WikiHandler) CopyTranslation(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingID)
+		return nil
+	}
+
+	language, sourceLanguage, ok := parseCopyLanguages(c)
+	if !ok {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLanguage)
+		return nil
+	}
+
+	userID, exists := c.Locals("user_id").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingUserID)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	var req request.CopyTranslationRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, err, libs_helper.ErrInvalidRequest)
+			return nil
+		}
+	}
+
+	if err := h.wikiUseCase.CopyTranslation(ctx, id, language, sourceLanguage, req, userID); err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Translation copied successfully", nil)
+}
+
+func (h *WikiHandler) CopyTranslations(c *fiber.Ctx) error {
+	typeParam := c.Query("type")
+	if typeParam == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingType)
+		return nil
+	}
+
+	language, sourceLanguage, ok := parseCopyLanguages(c)
+	if !ok {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLanguage)
+		return nil
+	}
+
+	userID, exists := c.Locals("user_id").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingUserID)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	var req request.CopyTranslationRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, err, libs_helper.ErrInvalidRequest)
+			return nil
+		}
+	}
+
+	result, err := h.wikiUseCase.CopyTranslations(ctx, typeParam, language, sourceLanguage, req, userID)
+	if err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Translations copied successfully", result)
+}
+
+func (h *WikiHandler) MachineTranslate(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingID)
+		return nil
+	}
+
+	language, sourceLanguage, ok := parseCopyLanguages(c)
+	if !ok {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLanguage)
+		return nil
+	}
+
+	userID, exists := c.Locals("user_id").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingUserID)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	var req request.MachineTranslateRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			_ = libs_helper.SendError(c, fiber.StatusBadRequest, err, libs_helper.ErrInvalidRequest)
+			return nil
+		}
+	}
+
+	if err := h.wikiUseCase.MachineTranslate(ctx, id, language, sourceLanguage, req, userID); err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Translation drafted successfully", nil)
+}
+
+func (h *WikiHandler) ScheduleWiki(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingID)
+		return nil
+	}
+
+	userID, exists := c.Locals("user_id").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingUserID)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	var req request.ScheduleWikiRequest
+	if err := c.BodyParser(&req); err != nil {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, err, libs_helper.ErrInvalidRequest)
+		return nil
+	}
+
+	if err := h.wikiUseCase.ScheduleWiki(ctx, id, req, userID); err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Wiki schedule updated successfully", nil)
+}
+
+func (h *WikiHandler) DeleteWiki(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingID)
+		return nil
+	}
+
+	userID, exists := c.Locals("user_id").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingUserID)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	if err := h.wikiUseCase.DeleteWiki(ctx, id, userID); err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Wiki moved to trash successfully", nil)
+}
+
+func (h *WikiHandler) RestoreWiki(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingID)
+		return nil
+	}
+
+	userID, exists := c.Locals("user_id").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingUserID)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	if err := h.wikiUseCase.RestoreWiki(ctx, id, userID); err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Wiki restored successfully", nil)
+}
+
+func (h *WikiHandler) GetTrash(c *fiber.Ctx) error {
+	pageParam := c.Query("page", "1")
+	page, err := strconv.Atoi(pageParam)
+	if err != nil || page < 1 {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidPage)
+		return nil
+	}
+
+	limitParam := c.Query("limit", "20")
+	limit, err := strconv.Atoi(limitParam)
+	if err != nil || limit < 1 {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrInvalidLimit)
+		return nil
+	}
+
+	typeParam := c.Query("type")
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	wikiResponses, total, err := h.wikiUseCase.GetTrash(ctx, page, limit, typeParam)
+	if err != nil {
+		return err
+	}
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	response := fiber.Map{
+		"items":       wikiResponses,
+		"page":        page,
+		"limit":       limit,
+		"total":       total,
+		"total_pages": totalPages,
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Trash fetched successfully", response)
+}
+
+func (h *WikiHandler) GetContributors(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		_ = libs_helper.SendError(c, fiber.StatusBadRequest, nil, libs_helper.ErrMissingID)
+		return nil
+	}
+
+	token, exists := c.Locals("token").(string)
+	if !exists {
+		_ = libs_helper.SendError(c, fiber.StatusUnauthorized, nil, libs_helper.ErrMissingToken)
+		return nil
+	}
+
+	ctx := context.WithValue(c.Context(), libs_constant.Token, token)
+
+	contributors, err := h.wikiUseCase.GetContributors(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return libs_helper.SendSuccess(c, fiber.StatusOK, "Contributors fetched successfully", contributors)
+}
+
+// parsePreview reports whether the caller asked for the draft copy via
+// ?preview=draft. ok is false when a draft was requested without edit rights.
+func parsePreview(c *fiber.Ctx) (draft bool, ok bool) {
+	if c.Query("preview") != "draft" {
+		return false, true
+	}
+	return true, canEditContent(c)
+}
+
+// canEditContent reports whether the current user may see and edit drafts.
+// It mirrors UpdateWiki, which only needs an authenticated user.
+func canEditContent(c *fiber.Ctx) bool {
+	userID, _ := c.Locals("user_id").(string)
+	return userID != ""
+}
+
+// parseCopyLanguages reads the :lang and :sourceLang path parameters.
+func parseCopyLanguages(c *fiber.Ctx) (int, int, bool) {
+	language, err := strconv.Atoi(c.Params("lang"))
+	if err != nil || language < 0 {
+		return 0, 0, false
+	}
+
+	sourceLanguage, err := strconv.Atoi(c.Params("sourceLang"))
+	if err != nil || sourceLanguage < 0 {
+		return 0, 0, false
+	}
+
+	return language, sourceLanguage, true
+}
+
+// withOrganization carries the caller's active organization so reads can
+// apply that organization's language fallback chain.
+func withOrganization(ctx context.Context, c *fiber.Ctx) context.Context {
+	user, ok := c.Locals(string(libs_constant.CurrentUserKey)).(*user_gateway_dto.CurrentUser)
+	if !ok || user == nil || user.OrganizationIdActive == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, libs_constant.OrganizationID, user.OrganizationIdActive)
+}
@@ -13,19 +13,24 @@ import (
 	libs_constant "wiki-service/pkg/libs/constant"
 )
 
+// WikiToResponse maps a wiki for the API. fields limits the output to the
+// selected fields and skips URL resolution for the rest; nil maps
+// everything.
 func WikiToResponse(
 	ctx context.Context,
 	wiki *entity.Wiki,
+	fields entity.WikiFields,
 	fileGateway gateway.FileGateway,
 	mediaGateway gateway.MediaGateway,
 	createdByUser *response.CreatedByUserInfo,
+	updatedByUser *response.CreatedByUserInfo,
 ) *response.WikiResponse {
 	if wiki == nil {
 		return nil
 	}
 
 	var imageWiki string
-	if wiki.ImageWiki != "" {
+	if wiki.ImageWiki != "" && fields.Has(entity.WikiFieldImage) {
 		url, err := fileGateway.GetImageUrl(ctx, file_gateway_dto.GetFileUrlRequest{
 			Key:  wiki.ImageWiki,
 			Mode: string(libs_constant.ImageModePublic),
@@ -40,33 +45,47 @@ func WikiToResponse(
 		Code:          wiki.Code,
 		ImageWiki:     imageWiki,
 		Public:        wiki.Public,
+		PublishAt:     wiki.PublishAt,
+		UnpublishAt:   wiki.UnpublishAt,
+		CreatedBy:     wiki.CreatedBy,
 		CreatedByUser: createdByUser,
+		UpdatedBy:     wiki.UpdatedBy,
+		UpdatedByUser: updatedByUser,
 		CreatedAt:     wiki.CreatedAt,
 		UpdatedAt:     wiki.UpdatedAt,
+		DeletedAt:     wiki.DeletedAt,
+		DeletedBy:     wiki.DeletedBy,
 	}
 
 	resp.Translation = make([]response.TranslationResponse, 0, len(wiki.Translation))
 	for _, tran := range wiki.Translation {
-		elements := make([]response.ElementResponse, 0, len(tran.Elements))
-		for _, elem := range tran.Elements {
-			value := elem.Value // giữ nguyên từ DB
+		sourceElements := tran.Elements
+		if !fields.Has(entity.WikiFieldElements) {
+			sourceElements = nil
+		}
+
+		elements := make([]response.ElementResponse, 0, len(sourceElements))
+		for _, elem := range sourceElements {
+			// Đọc phần tử chưa migrate như đã migrate (elem là bản sao)
+			elem.MigratePayload()
+			value := elem.RawValue() // dạng value cũ cho client
 			var imageUrl *string
 			var pdfUrl *string
+			var valueJson *string
 
 			// Xử lý ảnh / PDF để gán URL hiển thị
-			var valueJson *string
-			if value != nil && fileGateway != nil {
+			if elem.Media != nil && fileGateway != nil {
 				switch strings.ToLower(elem.Type) {
 				case "large_picture", "banner", "linked_in", "graphic":
 					url, err := fileGateway.GetImageUrl(ctx, file_gateway_dto.GetFileUrlRequest{
-						Key:  *value,
+						Key:  elem.Media.Key,
 						Mode: string(libs_constant.ImageModePublic),
 					})
 					if err == nil && url != nil {
 						imageUrl = url
 						// Tạo JSON string object cho banner và các type image
 						jsonObj := map[string]string{
-							"key_url":   *value,
+							"key_url":   elem.Media.Key,
 							"image_url": *url,
 						}
 						jsonBytes, _ := json.Marshal(jsonObj)
@@ -77,76 +96,54 @@ func WikiToResponse(
 					}
 				case "document":
 					url, err := fileGateway.GetPDFUrl(ctx, file_gateway_dto.GetFileUrlRequest{
-						Key:  *value,
+						Key:  elem.Media.Key,
 						Mode: string(libs_constant.ImageModePublic),
 					})
 					if err == nil && url != nil {
 						pdfUrl = url
 					}
-				default:
-					// Cho các type khác, kiểm tra nếu value là JSON hợp lệ thì lưu vào value_json
-					if strings.TrimSpace(*value) != "" && (strings.HasPrefix(*value, "{") || strings.HasPrefix(*value, "[")) {
-						var temp interface{}
-						if json.Unmarshal([]byte(*value), &temp) == nil {
-							valueJson = value // Lưu toàn bộ JSON string
-						}
-					}
 				}
 			}
 
-			// Xử lý button / button_url
+			// Title / button / button_url lấy trực tiếp từ payload
+			var title *response.TitleResponse
 			var btn *response.ButtonResponse
 			var btnUrl *response.ButtonUrlResponse
-			if value != nil && *value != "" {
-				if strings.EqualFold(elem.Type, "button") {
-					_ = json.Unmarshal([]byte(*value), &btn)
-					if btn != nil && btn.ButtonIcon != "" {
-						btnIconUrl, err := fileGateway.GetImageUrl(ctx, file_gateway_dto.GetFileUrlRequest{
-							Key:  btn.ButtonIcon,
-							Mode: string(libs_constant.ImageModePublic),
-						})
-						if err == nil && btnIconUrl != nil {
-							btn.ButtonIconUrl = *btnIconUrl
-						}
-					}
-				} else if strings.EqualFold(elem.Type, "button_url") {
-					_ = json.Unmarshal([]byte(*value), &btnUrl)
-					if btnUrl != nil && btnUrl.ButtonIcon != "" {
-						btnIconUrl, err := fileGateway.GetImageUrl(ctx, file_gateway_dto.GetFileUrlRequest{
-							Key:  btnUrl.ButtonIcon,
-							Mode: string(libs_constant.ImageModePublic),
-						})
-						if err == nil && btnIconUrl != nil {
-							btnUrl.ButtonIconUrl = *btnIconUrl
-						}
-					}
+			switch {
+			case elem.Title != nil:
+				valueJson = value
+				title = &response.TitleResponse{
+					Title:    elem.Title.Title,
+					ImageKey: elem.Title.ImageKey,
+					ImageUrl: imageURL(ctx, fileGateway, elem.Title.ImageKey),
 				}
-			}
-
-			var title *response.TitleResponse
-			if value != nil && *value != "" {
-				if strings.EqualFold(elem.Type, "title") {
-					// Thử parse JSON trước
-					title = &response.TitleResponse{}
-					if err := json.Unmarshal([]byte(*value), title); err != nil {
-						// Nếu không phải JSON, coi như plain string và tạo object
-						title.Title = *value // Plain string làm title
-						title.ImageKey = ""  // Không có image
-						title.ImageUrl = ""  // Không có image URL
-					} else {
-						// Là JSON object, xử lý image như cũ
-						if title.ImageKey != "" {
-							url, err := fileGateway.GetImageUrl(ctx, file_gateway_dto.GetFileUrlRequest{
-								Key:  title.ImageKey,
-								Mode: string(libs_constant.ImageModePublic),
-							})
-							if err == nil && url != nil {
-								title.ImageUrl = *url
-							}
-						}
-					}
+			case elem.Button != nil:
+				valueJson = value
+				btn = &response.ButtonResponse{
+					Title:         elem.Button.Title,
+					Code:          elem.Button.Code,
+					ButtonIcon:    elem.Button.Icon,
+					ButtonIconUrl: imageURL(ctx, fileGateway, elem.Button.Icon),
+				}
+			case elem.Link != nil:
+				valueJson = value
+				btnUrl = &response.ButtonUrlResponse{
+					Title:         elem.Link.Title,
+					ButtonUrl:     elem.Link.URL,
+					ButtonIcon:    elem.Link.Icon,
+					ButtonIconUrl: imageURL(ctx, fileGateway, elem.Link.Icon),
+				}
+			case strings.EqualFold(elem.Type, "title") && value != nil && *value != "":
+				// Tiêu đề dạng chuỗi thường, không có ảnh
+				title = &response.TitleResponse{Title: *value}
+			case value != nil && strings.TrimSpace(*value) != "" && (strings.HasPrefix(*value, "{") || strings.HasPrefix(*value, "[")):
+				// Cho các type khác, kiểm tra nếu value là JSON hợp lệ thì lưu vào value_json
+				var temp interface{}
+				if json.Unmarshal([]byte(*value), &temp) == nil {
+					valueJson = value // Lưu toàn bộ JSON string
 				}
 			}
+
 			// Xử lý picture_keys
 			var pictureKeysUrl []response.PictureKeyUrl
 			var sortedPictureKeys []response.PictureItem
@@ -263,19 +260,98 @@ func WikiToResponse(
 			})
 		}
 
-		resp.Translation = append(resp.Translation, response.TranslationResponse{
-			Language: tran.Language,
-			Title:    tran.Title,
-			Keywords: tran.Keywords,
-			Level:    tran.Level,
-			Unit:     tran.Unit,
-			Elements: elements,
-		})
+		tranResp := response.TranslationResponse{
+			Language:    tran.Language,
+			Title:       tran.Title,
+			Keywords:    tran.Keywords,
+			Level:       tran.Level,
+			Unit:        tran.Unit,
+			Elements:    elements,
+			Status:      string(tran.Status),
+			PublishAt:   tran.PublishAt,
+			UnpublishAt: tran.UnpublishAt,
+		}
+		if tran.Published != nil {
+			publishedAt := tran.Published.PublishedAt
+			tranResp.PublishedAt = &publishedAt
+			tranResp.PublishedBy = tran.Published.PublishedBy
+		}
+		if mt := tran.MachineTranslated; mt != nil {
+			tranResp.MachineTranslated = &response.MachineTranslationResponse{
+				Provider:       mt.Provider,
+				SourceLanguage: mt.SourceLanguage,
+				TranslatedAt:   mt.TranslatedAt,
+				TranslatedBy:   mt.TranslatedBy,
+			}
+		}
+
+		resp.Translation = append(resp.Translation, tranResp)
 	}
 
+	if fields != nil {
+		resp.Select(fieldSelection(fields))
+	}
 	return resp
 }
 
+// responseKeys maps API field names to the JSON keys they cover.
+var responseKeys = map[string][]string{
+	entity.WikiFieldCreatedBy: {"created_by", "creator"},
+	entity.WikiFieldUpdatedBy: {"updated_by", "updater"},
+	entity.WikiFieldStatus:    {"status", "published_at", "published_by", "publish_at", "unpublish_at", "machine_translated"},
+}
+
+// fieldSelection turns selected fields into the JSON keys to keep. The id,
+// language resolution, search highlights and translation languages are
+// always kept.
+func fieldSelection(fields entity.WikiFields) *response.FieldSelection {
+	selection := &response.FieldSelection{
+		Wiki:        map[string]bool{"id": true, "language_resolution": true, "highlights": true},
+		Translation: map[string]bool{"language": true},
+	}
+
+	keys := func(field string) []string {
+		if mapped, ok := responseKeys[field]; ok {
+			return mapped
+		}
+		return []string{field}
+	}
+
+	for _, field := range entity.WikiTopLevelFields {
+		if fields[field] {
+			for _, key := range keys(field) {
+				selection.Wiki[key] = true
+			}
+		}
+	}
+	for _, field := range entity.WikiTranslationFields {
+		if fields[field] {
+			selection.Wiki["translation"] = true
+			for _, key := range keys(field) {
+				selection.Translation[key] = true
+			}
+		}
+	}
+	return selection
+}
+
+// imageURL resolves a public image URL, or returns "" when there is no key
+// or it cannot be resolved.
+func imageURL(ctx context.Context, fileGateway gateway.FileGateway, key string) string {
+	if key == "" || fileGateway == nil {
+		return ""
+	}
+
+	url, err := fileGateway.GetImageUrl(ctx, file_gateway_dto.GetFileUrlRequest{
+		Key:  key,
+		Mode: string(libs_constant.ImageModePublic),
+	})
+	if err != nil || url == nil {
+		return ""
+	}
+	return *url
+}
+
 func ElementsToResponse(elements []entity.Element) []response.ElementResponse {
 	if elements == nil {
 		return nil
@@ -307,7 +383,7 @@ func ElementsToResponse(elements []entity.Element) []response.ElementResponse {
 		resp[i] = response.ElementResponse{
 			Number:      elem.Number,
 			Type:        elem.Type,
-			Value:       elem.Value,
+			Value:       elem.RawValue(),
 			PictureKeys: pictureKeys,
 			VideoID:     elem.VideoID,
 		}
@@ -0,0 +1,39 @@
+package mapper
+
+import (
+	"wiki-service/internal/domain/entity"
+	"wiki-service/internal/interface/http/dto/response.go"
+)
+
+func ReviewToResponse(review *entity.ReviewRequest) *response.ReviewResponse {
+	if review == nil {
+		return nil
+	}
+
+	reviewers := make([]response.ReviewerResponse, len(review.Reviewers))
+	for i, reviewer := range review.Reviewers {
+		reviewers[i] = response.ReviewerResponse{
+			UserID:    reviewer.UserID,
+			Name:      reviewer.Name,
+			Email:     reviewer.Email,
+			Decision:  string(reviewer.Decision),
+			Comment:   reviewer.Comment,
+			DecidedAt: reviewer.DecidedAt,
+		}
+	}
+
+	return &response.ReviewResponse{
+		ID:          review.ID.Hex(),
+		WikiID:      review.WikiID.Hex(),
+		WikiType:    review.WikiType,
+		WikiCode:    review.WikiCode,
+		Language:    review.Language,
+		Status:      string(review.Status),
+		Note:        review.Note,
+		SubmittedBy: review.SubmittedBy,
+		Reviewers:   reviewers,
+		ResolvedAt:  review.ResolvedAt,
+		CreatedAt:   review.CreatedAt,
+		UpdatedAt:   review.UpdatedAt,
+	}
+}
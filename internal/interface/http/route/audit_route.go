@@ -0,0 +1,12 @@
+package route
+
+import (
+	"wiki-service/internal/interface/http/handler"
+	"wiki-service/internal/interface/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetUpAuditRoutes(api fiber.Router, auditHandler *handler.AuditHandler) {
+	api.Get("/audit", middleware.RequireAdmin(), auditHandler.GetAuditEntries)
+}
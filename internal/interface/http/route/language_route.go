@@ -0,0 +1,21 @@
+package route
+
+import (
+	"wiki-service/internal/interface/http/handler"
+	"wiki-service/internal/interface/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetUpLanguageRoutes(api fiber.Router, languageHandler *handler.LanguageHandler) {
+	languageGroups := api.Group("/languages")
+	{
+		languageGroups.Get("", languageHandler.GetLanguages)
+		languageGroups.Put("/:id", middleware.RequireAdmin(), languageHandler.SaveLanguage)
+
+		// Fallback chains, scoped to an organization or a wiki type
+		languageGroups.Get("/fallbacks", languageHandler.GetFallbacks)
+		languageGroups.Put("/fallbacks/:scope/:scopeId", middleware.RequireAdmin(), languageHandler.SaveFallback)
+		languageGroups.Delete("/fallbacks/:scope/:scopeId", middleware.RequireAdmin(), languageHandler.DeleteFallback)
+	}
+}
@@ -0,0 +1,23 @@
+package route
+
+import (
+	"wiki-service/internal/interface/http/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetUpReviewRoutes(api fiber.Router, reviewHandler *handler.ReviewHandler) {
+	// Submit a translation for review
+	api.Post("/wikis/:id/translations/:lang/reviews", reviewHandler.SubmitReview)
+
+	reviewGroups := api.Group("/reviews")
+	{
+		// Reviewer queue
+		reviewGroups.Get("/queue", reviewHandler.GetReviewQueue)
+
+		// Single review
+		reviewGroups.Get("/:id", reviewHandler.GetReview)
+		reviewGroups.Post("/:id/approve", reviewHandler.ApproveReview)
+		reviewGroups.Post("/:id/reject", reviewHandler.RejectReview)
+	}
+}
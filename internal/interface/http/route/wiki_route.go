@@ -3,26 +3,34 @@ package route
 import (
 	"wiki-service/internal/interface/http/handler"
 	"wiki-service/internal/interface/middleware"
-	"wiki-service/pkg/gateway"
 
 	"github.com/gofiber/fiber/v2"
 )
 
-func SetUpWikiRoutes(app *fiber.App, serviceHandler *handler.WikiHandler, userGateway gateway.UserGateway) {
-	api := app.Group("/api/v1")
-	api.Use(middleware.Secured(userGateway))
-
+func SetUpWikiRoutes(api fiber.Router, serviceHandler *handler.WikiHandler) {
 	wikiGroups := api.Group("/wikis")
 	{
-		 // Templates
+		// Templates
 		wikiGroups.Post("/template", serviceHandler.CreateWikiTemplate)
 		wikiGroups.Get("/template", serviceHandler.GetTemplate)
 
 		// Statistics
 		wikiGroups.Get("/statistics", serviceHandler.GetStatistics)
+		wikiGroups.Get("/statistics/progress", serviceHandler.GetTranslationProgress)
+		wikiGroups.Get("/statistics/summary", serviceHandler.GetTypeSummary)
+		wikiGroups.Get("/statistics/export", serviceHandler.ExportStatistics)
+		wikiGroups.Get("/statistics/trends", serviceHandler.GetCompletionTrends)
 
 		// Query by code
 		wikiGroups.Get("/code", serviceHandler.GetWikiByCode)
+		wikiGroups.Post("/batch", serviceHandler.GetWikisByCodes)
+
+		// Autocomplete
+		wikiGroups.Get("/suggest", serviceHandler.SuggestWikis)
+
+		// Trash
+		wikiGroups.Get("/trash", serviceHandler.GetTrash)
+		wikiGroups.Post("/:id/restore", serviceHandler.RestoreWiki)
 
 		// List
 		wikiGroups.Get("", serviceHandler.GetWikis)
@@ -30,6 +38,16 @@ func SetUpWikiRoutes(app *fiber.App, serviceHandler *handler.WikiHandler, userGa
 		// Single item
 		wikiGroups.Get("/:id", serviceHandler.GetWikiByID)
 		wikiGroups.Put("/:id", serviceHandler.UpdateWiki)
-	}
+		wikiGroups.Delete("/:id", serviceHandler.DeleteWiki)
+		wikiGroups.Get("/:id/contributors", serviceHandler.GetContributors)
+
+		// Publishing outside the review workflow is reserved for admins
+		wikiGroups.Post("/:id/translations/:lang/publish", middleware.RequireAdmin(), serviceHandler.PublishTranslation)
+		wikiGroups.Put("/:id/schedule", middleware.RequireAdmin(), serviceHandler.ScheduleWiki)
 
+		// Bootstrap a language from an existing translation
+		wikiGroups.Post("/:id/translations/:lang/copy-from/:sourceLang", serviceHandler.CopyTranslation)
+		wikiGroups.Post("/translations/:lang/copy-from/:sourceLang", middleware.RequireAdmin(), serviceHandler.CopyTranslations)
+		wikiGroups.Post("/:id/translations/:lang/machine-translate/:sourceLang", serviceHandler.MachineTranslate)
+	}
 }
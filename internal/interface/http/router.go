@@ -13,11 +13,15 @@ import (
 // SetupRouter sets up the Fiber router
 func SetupRouter(
 	wikiHandler *handler.WikiHandler,
+	reviewHandler *handler.ReviewHandler,
+	auditHandler *handler.AuditHandler,
+	languageHandler *handler.LanguageHandler,
 	auditMiddleware *middleware.AuditMiddleware,
 	userGateway gateway.UserGateway,
 ) *fiber.App {
 	app := fiber.New(fiber.Config{
-		AppName: "Services Management v1.0",
+		AppName:      "Services Management v1.0",
+		ErrorHandler: middleware.ErrorHandler,
 	})
 
 	// Apply global middlewares
@@ -33,7 +37,11 @@ func SetupRouter(
 		})
 	})
 
-	route.SetUpWikiRoutes(app, wikiHandler, userGateway)
+	api := app.Group("/api/v1", middleware.Secured(userGateway))
+	route.SetUpWikiRoutes(api, wikiHandler)
+	route.SetUpReviewRoutes(api, reviewHandler)
+	route.SetUpAuditRoutes(api, auditHandler)
+	route.SetUpLanguageRoutes(api, languageHandler)
 
 	return app
 }
@@ -8,7 +8,8 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-// AuditMiddleware logs all requests for audit purposes
+// AuditMiddleware logs raw requests to the audit log file. Content changes
+// are recorded with actor and field diff by the use cases in audit_logs.
 type AuditMiddleware struct {
 	logger *logger.Logger
 }
@@ -84,49 +85,6 @@ func shouldAudit(path, method string) bool {
 	return false
 }
 
-// determineAction determines the action based on path and method
-func determineAction(path, method string) string {
-	// Map common paths to actions
-	pathActions := map[string]string{
-		"/api/v1/auth/register": "user.register",
-		"/api/v1/auth/login":    "user.login",
-		"/api/v1/auth/logout":   "user.logout",
-		"/api/v1/auth/profile":  "user.view_profile",
-	}
-
-	if action, ok := pathActions[path]; ok {
-		return action
-	}
-
-	// Default action based on method
-	switch method {
-	case "GET":
-		return "resource.view"
-	case "POST":
-		return "resource.create"
-	case "PUT", "PATCH":
-		return "resource.update"
-	case "DELETE":
-		return "resource.delete"
-	default:
-		return "resource.access"
-	}
-}
-
-// determineResource determines the resource type from path
-func determineResource(path string) string {
-	if contains(path, "/auth") {
-		return "auth"
-	}
-	if contains(path, "/users") {
-		return "user"
-	}
-	if contains(path, "/profile") {
-		return "profile"
-	}
-	return "unknown"
-}
-
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && findSubstring(s, substr)
 }
@@ -0,0 +1,14 @@
+package middleware
+
+import (
+	libs_helper "wiki-service/pkg/libs/helper"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ErrorHandler is the application-wide Fiber error handler. Handlers return
+// use case errors as-is and this turns them into the standard response
+// envelope with the matching status code.
+func ErrorHandler(c *fiber.Ctx, err error) error {
+	return libs_helper.SendAppError(c, err)
+}
@@ -9,11 +9,14 @@ import (
 
 // Config holds all application configuration
 type Config struct {
-	Server   ServerConfig
-	MongoDB  MongoDBConfig
-	Consul   ConsulConfig
-	Registry RegistryConfig
-	Database DatabaseConfig
+	Server     ServerConfig
+	MongoDB    MongoDBConfig
+	Consul     ConsulConfig
+	Registry   RegistryConfig
+	Database   DatabaseConfig
+	Scheduler  SchedulerConfig
+	Trash      TrashConfig
+	Translator TranslatorConfig
 }
 
 // ServerConfig holds server configuration
@@ -55,6 +58,27 @@ type RedisCacheConfig struct {
 	DB       int
 }
 
+// SchedulerConfig holds background job configuration
+type SchedulerConfig struct {
+	Enabled         bool
+	IntervalSeconds int
+	// ServiceToken authenticates background jobs against other services
+	ServiceToken string
+}
+
+// TrashConfig holds soft delete configuration
+type TrashConfig struct {
+	RetentionDays int
+}
+
+// TranslatorConfig holds machine translation provider configuration
+type TranslatorConfig struct {
+	Provider       string // "stub" (default, offline) or "google"
+	APIKey         string
+	Endpoint       string // Optional - overrides the provider's default URL
+	TimeoutSeconds int
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	// Load .env file if exists (errors ignored)
@@ -87,6 +111,20 @@ func Load() (*Config, error) {
 				DB:       getEnvAsInt("REDIS_DB", 0),
 			},
 		},
+		Scheduler: SchedulerConfig{
+			Enabled:         getEnv("SCHEDULER_ENABLED", "true") == "true",
+			IntervalSeconds: getEnvAsPositiveInt("SCHEDULER_INTERVAL_SECONDS", 60),
+			ServiceToken:    getEnv("SCHEDULER_SERVICE_TOKEN", ""),
+		},
+		Trash: TrashConfig{
+			RetentionDays: getEnvAsPositiveInt("TRASH_RETENTION_DAYS", 30),
+		},
+		Translator: TranslatorConfig{
+			Provider:       getEnv("TRANSLATOR_PROVIDER", ""),
+			APIKey:         getEnv("TRANSLATOR_API_KEY", ""),
+			Endpoint:       getEnv("TRANSLATOR_ENDPOINT", ""),
+			TimeoutSeconds: getEnvAsInt("TRANSLATOR_TIMEOUT_SECONDS", 30),
+		},
 	}, nil
 }
 
@@ -109,3 +147,12 @@ func getEnvAsInt(key string, defaultValue int) int {
 	}
 	return value
 }
+
+// getEnvAsPositiveInt is getEnvAsInt for values that must be above zero,
+// such as intervals; anything else falls back to the default.
+func getEnvAsPositiveInt(key string, defaultValue int) int {
+	if value := getEnvAsInt(key, defaultValue); value > 0 {
+		return value
+	}
+	return defaultValue
+}
@@ -17,6 +17,7 @@ import (
 
 type UserGateway interface {
 	GetCurrentUser(ctx context.Context) (*user_gateway_dto.CurrentUser, error)
+	GetUserByID(ctx context.Context, userID string) (*user_gateway_dto.CurrentUser, error)
 	GetUserByTeacher(ctx context.Context, teacherID string) (*user_gateway_dto.CurrentUser, error)
 	GetStudentInfo(ctx context.Context, studentID string) (*user_gateway_dto.StudentResponse, error)
 	GetTeacherInfo(ctx context.Context, teacherID string) (*user_gateway_dto.TeacherResponse, error)
@@ -75,6 +76,49 @@ func (g *userGatewayImpl) GetCurrentUser(ctx context.Context) (*user_gateway_dto
 	return &gwResp.Data, nil
 }
 
+func (g *userGatewayImpl) GetUserByID(ctx context.Context, userID string) (*user_gateway_dto.CurrentUser, error) {
+	userCache, err := g.cachedMainGateway.GetUserCache(ctx, userID)
+	if err != nil {
+		fmt.Printf("warning: get user cache failed: %v\n", err)
+	} else if userCache != nil {
+		var user user_gateway_dto.CurrentUser
+		b, _ := json.Marshal(userCache)
+		if err := json.Unmarshal(b, &user); err == nil && user.ID != "" {
+			return &user, nil
+		}
+	}
+
+	token, ok := ctx.Value(libs_constant.Token).(string)
+	if !ok {
+		return nil, fmt.Errorf("token not found in context")
+	}
+
+	client, err := NewGatewayClient(g.serviceName, token, g.consul, nil, g.logger)
+	if err != nil {
+		return nil, fmt.Errorf("init GatewayClient fail: %w", err)
+	}
+
+	headers := libs_helper.GetHeaders(ctx)
+
+	resp, err := client.Call("GET", "/v1/gateway/users/"+userID, nil, headers)
+	if err != nil {
+		return nil, fmt.Errorf("call API user fail: %w", err)
+	}
+
+	// Unmarshal response theo format Gateway
+	var gwResp response.APIGateWayResponse[user_gateway_dto.CurrentUser]
+	if err := json.Unmarshal(resp, &gwResp); err != nil {
+		return nil, fmt.Errorf("unmarshal response fail: %w", err)
+	}
+
+	// Check status_code trả về
+	if gwResp.StatusCode != 200 {
+		return nil, fmt.Errorf("gateway error: %s", gwResp.Message)
+	}
+
+	return &gwResp.Data, nil
+}
+
 func (g *userGatewayImpl) GetStudentInfo(ctx context.Context, studentID string) (*user_gateway_dto.StudentResponse, error) {
 
 	studentCache, err := g.cachedMainGateway.GetStudentCache(ctx, studentID)
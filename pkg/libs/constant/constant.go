@@ -118,6 +118,7 @@ var (
 	CurrentUserKey ContextKey = "currentUser"
 	UserRoles      ContextKey = "roles"
 	AppLanguage    ContextKey = "app_language"
+	OrganizationID ContextKey = "organization_id"
 )
 
 type ImageMode string
@@ -0,0 +1,147 @@
+package libs_errors
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kind classifies an AppError; the HTTP layer maps each kind to a status.
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindValidation
+	KindNotFound
+	KindConflict
+	KindForbidden
+	KindUnavailable
+)
+
+// Machine-readable error codes returned in the error_code field.
+const (
+	CodeInternal   = "ERR_INTERNAL"
+	CodeValidation = "ERR_VALIDATION"
+	CodeInvalidID  = "ERR_INVALID_ID"
+	CodeForbidden  = "ERR_FORBIDDEN"
+	CodeConflict   = "ERR_CONFLICT"
+
+	CodeWikiNotFound        = "ERR_WIKI_NOT_FOUND"
+	CodeWikiNotInTrash      = "ERR_WIKI_NOT_IN_TRASH"
+	CodeTemplateNotFound    = "ERR_TEMPLATE_NOT_FOUND"
+	CodeTranslationNotFound = "ERR_TRANSLATION_NOT_FOUND"
+	CodeReviewNotFound      = "ERR_REVIEW_NOT_FOUND"
+	CodeFallbackNotFound    = "ERR_FALLBACK_NOT_FOUND"
+
+	CodeNothingToReview = "ERR_NOTHING_TO_REVIEW"
+	CodeReviewPending   = "ERR_REVIEW_PENDING"
+	CodeReviewClosed    = "ERR_REVIEW_CLOSED"
+	CodeReviewStale     = "ERR_REVIEW_STALE"
+	CodeAlreadyDecided  = "ERR_ALREADY_DECIDED"
+	CodeNotReviewer     = "ERR_NOT_REVIEWER"
+
+	CodeTranslationExists = "ERR_TRANSLATION_EXISTS"
+	CodeMachineTranslated = "ERR_MACHINE_TRANSLATED"
+	CodeWikiModified      = "ERR_WIKI_MODIFIED"
+
+	CodeTranslatorUnavailable = "ERR_TRANSLATOR_UNAVAILABLE"
+)
+
+// Field-level validation codes used in FieldError.Code.
+const (
+	FieldRequired            = "REQUIRED"
+	FieldInvalidFormat       = "INVALID_FORMAT"
+	FieldMustBePositive      = "MUST_BE_POSITIVE"
+	FieldMustBeNonNegative   = "MUST_BE_NON_NEGATIVE"
+	FieldMustBeAfter         = "MUST_BE_AFTER"
+	FieldMustDiffer          = "MUST_DIFFER"
+	FieldDuplicate           = "DUPLICATE"
+	FieldTooMany             = "TOO_MANY"
+	FieldUserNotFound        = "USER_NOT_FOUND"
+	FieldSelfReview          = "SELF_REVIEW"
+	FieldUnsupportedLanguage = "UNSUPPORTED_LANGUAGE"
+)
+
+// FieldError describes why a single request field was rejected.
+type FieldError struct {
+	Field   string                 `json:"field"`
+	Code    string                 `json:"code"`
+	Message string                 `json:"message"`
+	Params  map[string]interface{} `json:"params,omitempty"`
+}
+
+// AppError is an error that knows how it should be reported to clients.
+type AppError struct {
+	Kind    Kind
+	Code    string
+	Message string
+	Fields  []FieldError
+	Err     error
+}
+
+func (e *AppError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Err)
+	}
+	return e.Message
+}
+
+func (e *AppError) Unwrap() error {
+	return e.Err
+}
+
+// As extracts an AppError from err's chain.
+func As(err error) (*AppError, bool) {
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		return appErr, true
+	}
+	return nil, false
+}
+
+func NotFound(code, message string) *AppError {
+	return &AppError{Kind: KindNotFound, Code: code, Message: message}
+}
+
+func Conflict(code, message string) *AppError {
+	return &AppError{Kind: KindConflict, Code: code, Message: message}
+}
+
+func Forbidden(code, message string) *AppError {
+	return &AppError{Kind: KindForbidden, Code: code, Message: message}
+}
+
+// Unavailable reports a feature that is not configured on this deployment.
+func Unavailable(code, message string) *AppError {
+	return &AppError{Kind: KindUnavailable, Code: code, Message: message}
+}
+
+func Validation(message string, fields ...FieldError) *AppError {
+	return &AppError{Kind: KindValidation, Code: CodeValidation, Message: message, Fields: fields}
+}
+
+// Internal wraps an unexpected error, keeping its cause for logging.
+func Internal(message string, err error) *AppError {
+	return &AppError{Kind: KindInternal, Code: CodeInternal, Message: message, Err: err}
+}
+
+// Required reports a missing field.
+func Required(field string) *AppError {
+	message := field + " is required"
+	return Validation(message, FieldError{Field: field, Code: FieldRequired, Message: message})
+}
+
+// InvalidID reports a field that is not a valid ObjectID.
+func InvalidID(field string) *AppError {
+	message := "invalid " + field + " format"
+	return &AppError{
+		Kind:    KindValidation,
+		Code:    CodeInvalidID,
+		Message: message,
+		Fields:  []FieldError{{Field: field, Code: FieldInvalidFormat, Message: message}},
+	}
+}
+
+// InvalidField reports a single field failing a rule.
+func InvalidField(field, code, message string, params map[string]interface{}) *AppError {
+	return Validation(message, FieldError{Field: field, Code: code, Message: message, Params: params})
+}
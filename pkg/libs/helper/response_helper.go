@@ -2,6 +2,9 @@ package libs_helper
 
 import (
 	"wiki-service/logger"
+	libs_constant "wiki-service/pkg/libs/constant"
+	libs_errors "wiki-service/pkg/libs/errors"
+	libs_i18n "wiki-service/pkg/libs/i18n"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -11,6 +14,22 @@ const (
 	ErrInvalidRequest   = "ERR_INVALID_REQUEST"
 	ErrNotFound         = "ERR_NOT_FOUND"
 	ErrInternal         = "ERR_INTERNAL"
+
+	ErrMissingToken     = "ERR_MISSING_TOKEN"
+	ErrMissingUserID    = "ERR_MISSING_USER_ID"
+	ErrMissingID        = "ERR_MISSING_ID"
+	ErrMissingCode      = "ERR_MISSING_CODE"
+	ErrMissingType      = "ERR_MISSING_TYPE"
+	ErrMissingQuery     = "ERR_MISSING_QUERY"
+	ErrInvalidPage      = "ERR_INVALID_PAGE"
+	ErrInvalidLimit     = "ERR_INVALID_LIMIT"
+	ErrInvalidLanguage  = "ERR_INVALID_LANGUAGE"
+	ErrInvalidLevel     = "ERR_INVALID_LEVEL"
+	ErrInvalidPublic    = "ERR_INVALID_PUBLIC"
+	ErrInvalidFormat    = "ERR_INVALID_FORMAT"
+	ErrInvalidFrom      = "ERR_INVALID_FROM"
+	ErrInvalidTo        = "ERR_INVALID_TO"
+	ErrPreviewForbidden = "ERR_PREVIEW_FORBIDDEN"
 )
 
 type APIResponse struct {
@@ -19,6 +38,7 @@ type APIResponse struct {
 	Data       interface{} `json:"data,omitempty"`
 	Error      string      `json:"error,omitempty"`
 	ErrorCode  string      `json:"error_code,omitempty"`
+	Details    interface{} `json:"details,omitempty"`
 }
 
 func SendSuccess(c *fiber.Ctx, statusCode int, message string, data interface{}) error {
@@ -45,10 +65,114 @@ func SendError(c *fiber.Ctx, statusCode int, err error, errorCode string) error
 		"method":      c.Method(),
 	})
 
+	message := errMsg
+	if localized, ok := libs_i18n.Message(RequestLanguage(c), errorCode, nil); ok {
+		message = localized
+	}
+
 	return c.Status(statusCode).JSON(APIResponse{
 		StatusCode: statusCode,
-		Message:    errMsg,
+		Message:    message,
 		Error:      errMsg,
 		ErrorCode:  errorCode,
 	})
 }
+
+// RequestLanguage returns the caller's language as resolved by the auth
+// middleware, falling back to the X-App-Language header.
+func RequestLanguage(c *fiber.Ctx) uint {
+	if lang, ok := c.Locals(libs_constant.AppLanguage.String()).(uint); ok {
+		return lang
+	}
+	return ParseAppLanguage(c.Get("X-App-Language"), libs_i18n.DefaultLanguage)
+}
+
+// SendAppError writes err using the status and code carried by a typed
+// AppError. Fiber errors keep their own status; anything else is a 500.
+func SendAppError(c *fiber.Ctx, err error) error {
+	if appErr, ok := libs_errors.As(err); ok {
+		statusCode := StatusForKind(appErr.Kind)
+
+		logger.WriteLogEx("error", err.Error(), map[string]interface{}{
+			"status_code": statusCode,
+			"error_code":  appErr.Code,
+			"path":        c.Path(),
+			"method":      c.Method(),
+		})
+
+		// Internal causes stay in the log, clients only see the summary.
+		errMsg := appErr.Message
+		if appErr.Kind != libs_errors.KindInternal && appErr.Err != nil {
+			errMsg = appErr.Error()
+		}
+
+		language := RequestLanguage(c)
+		fields := localizeFields(language, appErr.Fields)
+
+		message := errMsg
+		if len(fields) > 0 {
+			message = fields[0].Message
+		} else if localized, ok := libs_i18n.Message(language, appErr.Code, nil); ok {
+			message = localized
+		}
+
+		resp := APIResponse{
+			StatusCode: statusCode,
+			Message:    message,
+			Error:      errMsg,
+			ErrorCode:  appErr.Code,
+		}
+		if len(fields) > 0 {
+			resp.Details = fields
+		}
+		return c.Status(statusCode).JSON(resp)
+	}
+
+	if fiberErr, ok := err.(*fiber.Error); ok {
+		errorCode := ErrInvalidRequest
+		switch {
+		case fiberErr.Code == fiber.StatusNotFound:
+			errorCode = ErrNotFound
+		case fiberErr.Code >= fiber.StatusInternalServerError:
+			errorCode = ErrInternal
+		}
+		return SendError(c, fiberErr.Code, fiberErr, errorCode)
+	}
+
+	return SendError(c, fiber.StatusInternalServerError, err, ErrInternal)
+}
+
+// StatusForKind maps an AppError kind to its HTTP status code.
+func StatusForKind(kind libs_errors.Kind) int {
+	switch kind {
+	case libs_errors.KindValidation:
+		return fiber.StatusBadRequest
+	case libs_errors.KindNotFound:
+		return fiber.StatusNotFound
+	case libs_errors.KindConflict:
+		return fiber.StatusConflict
+	case libs_errors.KindForbidden:
+		return fiber.StatusForbidden
+	case libs_errors.KindUnavailable:
+		return fiber.StatusServiceUnavailable
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
+
+// localizeFields returns a copy of fields with messages in the given language.
+// Fields without a catalog entry keep their original message.
+func localizeFields(language uint, fields []libs_errors.FieldError) []libs_errors.FieldError {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	result := make([]libs_errors.FieldError, len(fields))
+	for i, field := range fields {
+		result[i] = field
+		if localized, ok := libs_i18n.FieldMessage(language, field.Field, field.Code, field.Params); ok {
+			result[i].Message = localized
+		}
+	}
+	return result
+}
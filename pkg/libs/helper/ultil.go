@@ -39,3 +39,10 @@ func GetUserID(ctx context.Context) string {
 	}
 	return ""
 }
+
+func GetOrganizationID(ctx context.Context) string {
+	if organizationID, ok := ctx.Value(libs_constant.OrganizationID).(string); ok {
+		return organizationID
+	}
+	return ""
+}
@@ -0,0 +1,118 @@
+package libs_i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultLanguage is used when the caller's language has no catalog or the
+// catalog is missing a key.
+const DefaultLanguage uint = 1
+
+//go:embed messages/*.json
+var messageFiles embed.FS
+
+// catalogFile is the layout of one embedded messages/<tag>.json file.
+type catalogFile struct {
+	Language uint              `json:"language"`
+	Errors   map[string]string `json:"errors"`
+	Fields   map[string]string `json:"fields"`
+}
+
+var (
+	catalogs     = mustLoadCatalogs()
+	indexPattern = regexp.MustCompile(`\[\d+\]`)
+	firstIndex   = regexp.MustCompile(`\[(\d+)\]`)
+	placeholder  = regexp.MustCompile(`\{(\w+)\}`)
+)
+
+func mustLoadCatalogs() map[uint]catalogFile {
+	entries, err := messageFiles.ReadDir("messages")
+	if err != nil {
+		panic(fmt.Sprintf("i18n: failed to read messages: %v", err))
+	}
+
+	result := make(map[uint]catalogFile, len(entries))
+	for _, entry := range entries {
+		data, err := messageFiles.ReadFile("messages/" + entry.Name())
+		if err != nil {
+			panic(fmt.Sprintf("i18n: failed to read %s: %v", entry.Name(), err))
+		}
+
+		var file catalogFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			panic(fmt.Sprintf("i18n: invalid catalog %s: %v", entry.Name(), err))
+		}
+		result[file.Language] = file
+	}
+
+	if _, ok := result[DefaultLanguage]; !ok {
+		panic("i18n: missing catalog for the default language")
+	}
+	return result
+}
+
+// Message returns the localized message for an error code.
+func Message(language uint, code string, params map[string]interface{}) (string, bool) {
+	template, ok := lookup(language, func(c catalogFile) (string, bool) {
+		msg, ok := c.Errors[code]
+		return msg, ok
+	})
+	if !ok {
+		return "", false
+	}
+	return render(template, params), true
+}
+
+// FieldMessage returns the localized message for a field validation code.
+// A rule for the specific field (indexes stripped, e.g. "elements.number.DUPLICATE")
+// wins over the generic rule for the code.
+func FieldMessage(language uint, field, code string, params map[string]interface{}) (string, bool) {
+	specific := indexPattern.ReplaceAllString(field, "") + "." + code
+
+	template, ok := lookup(language, func(c catalogFile) (string, bool) {
+		if msg, ok := c.Fields[specific]; ok {
+			return msg, true
+		}
+		msg, ok := c.Fields[code]
+		return msg, ok
+	})
+	if !ok {
+		return "", false
+	}
+
+	values := map[string]interface{}{"field": field}
+	if match := firstIndex.FindStringSubmatch(field); match != nil {
+		var index int
+		_, _ = fmt.Sscanf(match[1], "%d", &index)
+		values["position"] = index + 1
+	}
+	for k, v := range params {
+		values[k] = v
+	}
+	return render(template, values), true
+}
+
+func lookup(language uint, find func(catalogFile) (string, bool)) (string, bool) {
+	if c, ok := catalogs[language]; ok {
+		if msg, ok := find(c); ok {
+			return msg, true
+		}
+	}
+	return find(catalogs[DefaultLanguage])
+}
+
+func render(template string, params map[string]interface{}) string {
+	if len(params) == 0 || !strings.Contains(template, "{") {
+		return template
+	}
+	return placeholder.ReplaceAllStringFunc(template, func(token string) string {
+		if v, ok := params[token[1:len(token)-1]]; ok {
+			return fmt.Sprint(v)
+		}
+		return token
+	})
+}
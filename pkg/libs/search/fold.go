@@ -0,0 +1,50 @@
+package libs_search
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Fold lowercases s, strips diacritics and collapses whitespace, so "Hoà"
+// and "hoa" compare equal. Vietnamese đ is a distinct letter rather than a
+// d with a mark, so it is mapped explicitly.
+func Fold(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, s)
+	if err != nil {
+		folded = s
+	}
+
+	folded = strings.Map(foldLetter, folded)
+
+	return strings.Join(strings.Fields(folded), " ")
+}
+
+// foldLetter lowercases r, mapping đ to d.
+func foldLetter(r rune) rune {
+	switch r {
+	case 'đ', 'Đ':
+		return 'd'
+	}
+	return unicode.ToLower(r)
+}
+
+// TextQuery turns raw user input into a $text search string. The input is
+// folded like the indexed fields, and characters $text treats as operators
+// (quotes for phrases, a leading minus for negation) are replaced by spaces
+// so every word is matched literally.
+func TextQuery(input string) string {
+	folded := strings.Map(func(r rune) rune {
+		switch r {
+		case '"', '-', '\\':
+			return ' '
+		}
+		return r
+	}, Fold(input))
+
+	return strings.Join(strings.Fields(folded), " ")
+}
@@ -0,0 +1,179 @@
+package libs_search
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Range is a match within a snippet, as rune offsets with End exclusive.
+type Range struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// ellipsis marks a snippet cut out of a longer text.
+const ellipsis = "…"
+
+// Terms splits raw user input into the folded words a search matches.
+func Terms(input string) []string {
+	return strings.Fields(TextQuery(input))
+}
+
+// Highlight finds terms in text the way the search matches them, ignoring
+// case and accents, and returns a snippet of at most maxLength runes around
+// the first match with the positions of every match inside it. A cut
+// snippet starts or ends with an ellipsis, which the positions account
+// for. It returns false when no term occurs in text.
+func Highlight(text string, terms []string, maxLength int) (string, []Range, bool) {
+	original := []rune(text)
+
+	// Fold rune by rune, remembering where each folded rune came from, so
+	// matches in the folded text map back onto the original
+	t := foldTransformer()
+	var folded []rune
+	var origin []int
+	for i, r := range original {
+		for _, f := range foldRune(t, r) {
+			folded = append(folded, f)
+			origin = append(origin, i)
+		}
+	}
+
+	matches := findTerms(folded, origin, terms)
+	if len(matches) == 0 {
+		return "", nil, false
+	}
+
+	start, end := snippetWindow(original, matches[0], maxLength)
+
+	var b strings.Builder
+	offset := -start
+	if start > 0 {
+		b.WriteString(ellipsis)
+		offset++
+	}
+	b.WriteString(string(original[start:end]))
+	if end < len(original) {
+		b.WriteString(ellipsis)
+	}
+
+	var ranges []Range
+	for _, match := range matches {
+		if match.Start >= start && match.End <= end {
+			ranges = append(ranges, Range{Start: match.Start + offset, End: match.End + offset})
+		}
+	}
+	return b.String(), ranges, true
+}
+
+// findTerms returns the merged, ordered matches of terms in folded text, as
+// ranges of the original runes. Like the $text index, a term only matches a
+// whole word, so "hoa" does not match inside "hoang".
+func findTerms(folded []rune, origin []int, terms []string) []Range {
+	var matches []Range
+	for _, term := range terms {
+		needle := []rune(term)
+		if len(needle) == 0 {
+			continue
+		}
+		for i := 0; i+len(needle) <= len(folded); i++ {
+			if hasRunes(folded[i:], needle) && isBoundary(folded, i-1) && isBoundary(folded, i+len(needle)) {
+				matches = append(matches, Range{Start: origin[i], End: origin[i+len(needle)-1] + 1})
+			}
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Start != matches[j].Start {
+			return matches[i].Start < matches[j].Start
+		}
+		return matches[i].End > matches[j].End
+	})
+
+	merged := matches[:0]
+	for _, match := range matches {
+		if last := len(merged) - 1; last >= 0 && match.Start <= merged[last].End {
+			if match.End > merged[last].End {
+				merged[last].End = match.End
+			}
+			continue
+		}
+		merged = append(merged, match)
+	}
+	return merged
+}
+
+// snippetWindow picks the runes to show around match: a quarter of the
+// snippet before it, widened to fill maxLength and trimmed to whole words
+// where that does not cut into the match.
+func snippetWindow(text []rune, match Range, maxLength int) (int, int) {
+	if len(text) <= maxLength {
+		return 0, len(text)
+	}
+
+	start := match.Start - maxLength/4
+	if start < 0 {
+		start = 0
+	}
+	end := start + maxLength
+	if end > len(text) {
+		end = len(text)
+		start = end - maxLength
+	}
+
+	if start > 0 && !unicode.IsSpace(text[start-1]) {
+		for i := start; i < match.Start; i++ {
+			if unicode.IsSpace(text[i]) {
+				start = i + 1
+				break
+			}
+		}
+	}
+	if end < len(text) && !unicode.IsSpace(text[end]) {
+		for i := end - 1; i > match.End; i-- {
+			if unicode.IsSpace(text[i]) {
+				end = i
+				break
+			}
+		}
+	}
+	return start, end
+}
+
+// isBoundary reports whether position i of text is outside a word: before
+// the start, past the end, or a rune that is neither a letter nor a digit.
+func isBoundary(text []rune, i int) bool {
+	if i < 0 || i >= len(text) {
+		return true
+	}
+	return !unicode.IsLetter(text[i]) && !unicode.IsDigit(text[i])
+}
+
+func hasRunes(s, prefix []rune) bool {
+	for i, r := range prefix {
+		if s[i] != r {
+			return false
+		}
+	}
+	return true
+}
+
+// foldTransformer strips diacritics like Fold. Transformers keep state, so
+// each Highlight call builds its own and reuses it for every rune.
+func foldTransformer() transform.Transformer {
+	return transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+}
+
+// foldRune folds a single rune like Fold, without collapsing whitespace.
+func foldRune(t transform.Transformer, r rune) []rune {
+	folded, _, err := transform.String(t, string(r))
+	if err != nil {
+		folded = string(r)
+	}
+	return []rune(strings.Map(foldLetter, folded))
+}
@@ -0,0 +1,53 @@
+package libs_search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		terms      []string
+		wantFound  bool
+		wantRanges []Range
+	}{
+		{
+			name:       "matches ignore case and accents",
+			text:       "Hướng dẫn Hoà",
+			terms:      []string{"hoa"},
+			wantFound:  true,
+			wantRanges: []Range{{Start: 10, End: 13}},
+		},
+		{
+			name:  "terms do not match inside a longer word",
+			text:  "Hoàng",
+			terms: []string{"hoa"},
+		},
+		{
+			name:       "punctuation ends a word",
+			text:       "(hoa), hoang",
+			terms:      []string{"hoa"},
+			wantFound:  true,
+			wantRanges: []Range{{Start: 1, End: 4}},
+		},
+		{
+			name:  "digits belong to the word",
+			text:  "v2",
+			terms: []string{"v"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ranges, found := Highlight(tt.text, tt.terms, 100)
+			if found != tt.wantFound {
+				t.Fatalf("found = %v, want %v", found, tt.wantFound)
+			}
+			if !reflect.DeepEqual(ranges, tt.wantRanges) {
+				t.Errorf("ranges = %v, want %v", ranges, tt.wantRanges)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"wiki-service/pkg/logger"
+)
+
+// Locker grants a named lease to a single owner at a time. Jobs only run on
+// the instance holding the lease, so several service instances can share one
+// database without applying the same job twice.
+type Locker interface {
+	TryLock(ctx context.Context, name, owner string, ttl time.Duration) (bool, error)
+}
+
+// DefaultInterval replaces a job interval that is zero or negative.
+const DefaultInterval = time.Minute
+
+// Job is a unit of periodic background work.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+type Scheduler struct {
+	locker Locker
+	logger *logger.Logger
+	owner  string
+	jobs   []Job
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewScheduler(locker Locker, log *logger.Logger) *Scheduler {
+	hostname, _ := os.Hostname()
+	return &Scheduler{
+		locker: locker,
+		logger: log,
+		owner:  fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), time.Now().UnixNano()),
+	}
+}
+
+// Register adds a job; it must be called before Start. A job without a
+// positive interval runs every DefaultInterval instead of panicking the
+// ticker.
+func (s *Scheduler) Register(job Job) {
+	if job.Interval <= 0 {
+		s.logger.Warn(fmt.Sprintf("scheduler: job %s has invalid interval %s, using %s", job.Name, job.Interval, DefaultInterval))
+		job.Interval = DefaultInterval
+	}
+	s.jobs = append(s.jobs, job)
+}
+
+// Start runs every registered job on its own ticker until Stop is called.
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go func(job Job) {
+			defer s.wg.Done()
+			s.loop(ctx, job)
+		}(job)
+	}
+}
+
+// Stop cancels running jobs and waits for them to return.
+func (s *Scheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.runOnce(ctx, job)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) runOnce(ctx context.Context, job Job) {
+	// Hold the lease slightly shorter than the interval so a crashed owner
+	// is replaced on the next tick.
+	ttl := job.Interval - job.Interval/10
+	acquired, err := s.locker.TryLock(ctx, job.Name, s.owner, ttl)
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("scheduler: lock %s failed: %v", job.Name, err))
+		return
+	}
+	if !acquired {
+		return
+	}
+
+	if err := job.Run(ctx); err != nil {
+		s.logger.Error(fmt.Sprintf("scheduler: job %s failed: %v", job.Name, err))
+	}
+}
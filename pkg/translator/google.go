@@ -0,0 +1,128 @@
+package translator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	defaultGoogleEndpoint = "https://translation.googleapis.com/language/translate/v2"
+	defaultTimeout        = 30 * time.Second
+	// googleMaxSegments is the number of texts the v2 API accepts per call.
+	googleMaxSegments = 128
+)
+
+type googleTranslator struct {
+	apiKey   string
+	endpoint string
+	client   *http.Client
+}
+
+// NewGoogleTranslator calls the Cloud Translation v2 REST API.
+func NewGoogleTranslator(apiKey, endpoint string, timeout time.Duration) Translator {
+	if endpoint == "" {
+		endpoint = defaultGoogleEndpoint
+	}
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &googleTranslator{
+		apiKey:   apiKey,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+func (t *googleTranslator) Name() string {
+	return ProviderGoogle
+}
+
+type googleTranslateRequest struct {
+	Q      []string `json:"q"`
+	Source string   `json:"source"`
+	Target string   `json:"target"`
+	Format string   `json:"format"`
+}
+
+type googleTranslateResponse struct {
+	Data struct {
+		Translations []struct {
+			TranslatedText string `json:"translatedText"`
+		} `json:"translations"`
+	} `json:"data"`
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (t *googleTranslator) Translate(ctx context.Context, texts []string, sourceLanguage, targetLanguage string) ([]string, error) {
+	translated := make([]string, 0, len(texts))
+	for start := 0; start < len(texts); start += googleMaxSegments {
+		end := start + googleMaxSegments
+		if end > len(texts) {
+			end = len(texts)
+		}
+
+		batch, err := t.translateBatch(ctx, texts[start:end], sourceLanguage, targetLanguage)
+		if err != nil {
+			return nil, err
+		}
+		translated = append(translated, batch...)
+	}
+	return translated, nil
+}
+
+func (t *googleTranslator) translateBatch(ctx context.Context, texts []string, sourceLanguage, targetLanguage string) ([]string, error) {
+	body, err := json.Marshal(googleTranslateRequest{
+		Q:      texts,
+		Source: sourceLanguage,
+		Target: targetLanguage,
+		Format: "text",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := t.endpoint + "?key=" + url.QueryEscape(t.apiKey)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("translator: google request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var decoded googleTranslateResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("translator: decode google response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK || decoded.Error != nil {
+		message := resp.Status
+		if decoded.Error != nil {
+			message = decoded.Error.Message
+		}
+		return nil, fmt.Errorf("translator: google returned %d: %s", resp.StatusCode, message)
+	}
+
+	if len(decoded.Data.Translations) != len(texts) {
+		return nil, fmt.Errorf("translator: google returned %d translations for %d texts", len(decoded.Data.Translations), len(texts))
+	}
+
+	translated := make([]string, len(texts))
+	for i, item := range decoded.Data.Translations {
+		translated[i] = html.UnescapeString(item.TranslatedText)
+	}
+	return translated, nil
+}
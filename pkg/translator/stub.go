@@ -0,0 +1,30 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+)
+
+type stubTranslator struct{}
+
+// NewStubTranslator returns a deterministic offline translator that only
+// prefixes each text with the target language, e.g. "[vi] Hello". It is the
+// default provider so the service and its tests need no network access.
+func NewStubTranslator() Translator {
+	return stubTranslator{}
+}
+
+func (stubTranslator) Name() string {
+	return ProviderStub
+}
+
+func (stubTranslator) Translate(_ context.Context, texts []string, _, targetLanguage string) ([]string, error) {
+	translated := make([]string, len(texts))
+	for i, text := range texts {
+		if text == "" {
+			continue
+		}
+		translated[i] = fmt.Sprintf("[%s] %s", targetLanguage, text)
+	}
+	return translated, nil
+}
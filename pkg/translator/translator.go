@@ -0,0 +1,47 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Translator turns a batch of texts from one language into another.
+// Languages are ISO 639 codes as stored in the language registry. The
+// result has the same length and order as texts.
+type Translator interface {
+	Name() string
+	Translate(ctx context.Context, texts []string, sourceLanguage, targetLanguage string) ([]string, error)
+}
+
+const (
+	ProviderStub   = "stub"
+	ProviderGoogle = "google"
+)
+
+// Config selects and configures a provider.
+type Config struct {
+	Provider string
+	APIKey   string
+	Endpoint string
+	Timeout  time.Duration
+}
+
+// New builds the translator for cfg.Provider. An empty provider disables
+// machine translation and returns a nil Translator; the offline stub must
+// be asked for by name.
+func New(cfg Config) (Translator, error) {
+	switch cfg.Provider {
+	case "":
+		return nil, nil
+	case ProviderStub:
+		return NewStubTranslator(), nil
+	case ProviderGoogle:
+		if cfg.APIKey == "" {
+			return nil, fmt.Errorf("translator: %s provider requires an API key", cfg.Provider)
+		}
+		return NewGoogleTranslator(cfg.APIKey, cfg.Endpoint, cfg.Timeout), nil
+	default:
+		return nil, fmt.Errorf("translator: unknown provider %q", cfg.Provider)
+	}
+}